@@ -0,0 +1,8 @@
+data "netbox_cable_trace" "uplink" {
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.uplink.id
+}
+
+output "uplink_peer_interface_ids" {
+  value = data.netbox_cable_trace.uplink.is_complete ? [for e in data.netbox_cable_trace.uplink.endpoint : e.object_id] : []
+}
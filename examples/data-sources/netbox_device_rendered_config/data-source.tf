@@ -0,0 +1,11 @@
+data "netbox_device_rendered_config" "edge01" {
+  device_id = netbox_device.edge01.id
+  context = jsonencode({
+    ntp_servers = ["192.0.2.1", "192.0.2.2"]
+  })
+}
+
+resource "local_file" "edge01_config" {
+  filename = "${path.module}/configs/edge01.cfg"
+  content  = data.netbox_device_rendered_config.edge01.content
+}
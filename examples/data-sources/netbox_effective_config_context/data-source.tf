@@ -0,0 +1,11 @@
+data "netbox_effective_config_context" "edge01" {
+  device_id = netbox_device.edge01.id
+}
+
+output "edge01_ntp_servers" {
+  value = jsondecode(data.netbox_effective_config_context.edge01.config_context).ntp_servers
+}
+
+output "edge01_config_contexts" {
+  value = data.netbox_effective_config_context.edge01.config_context_ids
+}
@@ -1,3 +1,15 @@
 data "netbox_ip_range" "cust_a_prod" {
   contains = "10.0.0.1/24"
 }
+
+data "netbox_ip_range" "dhcp_pool" {
+  contains            = "10.0.1.100/24"
+  include_utilization = true
+
+  lifecycle {
+    postcondition {
+      condition     = self.utilization_percent < 90
+      error_message = "The DHCP pool is more than 90% utilized."
+    }
+  }
+}
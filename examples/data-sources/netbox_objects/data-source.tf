@@ -0,0 +1,21 @@
+data "netbox_objects" "cables" {
+  endpoint = "dcim/cables"
+
+  filter {
+    name  = "site"
+    value = "fra1"
+  }
+
+  filter {
+    name  = "status__n"
+    value = "decommissioning"
+  }
+}
+
+locals {
+  cables = [for o in data.netbox_objects.cables.objects : jsondecode(o.json)]
+}
+
+output "cable_labels" {
+  value = [for c in local.cables : c.label]
+}
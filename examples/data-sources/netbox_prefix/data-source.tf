@@ -0,0 +1,19 @@
+data "netbox_prefix" "by_cidr" {
+  prefix = "10.0.0.0/24"
+}
+
+data "netbox_prefix" "servers" {
+  prefix              = "10.0.16.0/22"
+  include_utilization = true
+}
+
+resource "netbox_available_ip_address" "server" {
+  prefix_id = data.netbox_prefix.servers.id
+
+  lifecycle {
+    precondition {
+      condition     = data.netbox_prefix.servers.utilization_percent < 90
+      error_message = "The server prefix is more than 90% utilized, ${data.netbox_prefix.servers.used_ips} of ${data.netbox_prefix.servers.total_ips} addresses are in use."
+    }
+  }
+}
@@ -0,0 +1,15 @@
+data "netbox_racks" "test" {
+  filter {
+    name  = "name"
+    value = "rack-1"
+  }
+}
+
+data "netbox_rack_units" "front" {
+  rack_id = data.netbox_racks.test.racks[0].id
+  face    = "front"
+}
+
+output "free_units" {
+  value = data.netbox_rack_units.front.free_units
+}
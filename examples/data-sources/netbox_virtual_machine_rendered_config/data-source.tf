@@ -0,0 +1,7 @@
+data "netbox_virtual_machine_rendered_config" "web01" {
+  virtual_machine_id = netbox_virtual_machine.web01.id
+}
+
+output "web01_config" {
+  value = data.netbox_virtual_machine_rendered_config.web01.content
+}
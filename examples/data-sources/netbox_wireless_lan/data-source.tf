@@ -0,0 +1,4 @@
+data "netbox_wireless_lan" "corp" {
+  ssid     = "corp"
+  group_id = data.netbox_wireless_lan_group.campus.id
+}
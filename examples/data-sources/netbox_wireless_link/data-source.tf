@@ -0,0 +1,3 @@
+data "netbox_wireless_link" "backhaul" {
+  interface_a_id = 123
+}
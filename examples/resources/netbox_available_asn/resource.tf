@@ -0,0 +1,15 @@
+data "netbox_rir" "private" {
+  name = "private"
+}
+
+resource "netbox_asn_range" "datacenter" {
+  name   = "Datacenter BGP"
+  rir_id = data.netbox_rir.private.id
+  start  = 4200000000
+  end    = 4200009999
+}
+
+resource "netbox_available_asn" "leaf01" {
+  asn_range_id = netbox_asn_range.datacenter.id
+  description  = "leaf01"
+}
@@ -0,0 +1,21 @@
+data "netbox_prefix" "test" {
+  cidr = "10.0.0.0/24"
+}
+
+resource "netbox_available_ip_addresses" "cluster" {
+  prefix_id     = data.netbox_prefix.test.id
+  address_count = 3
+  contiguous    = true
+  dns_name      = "node-{number}.example.com"
+
+  address {}
+  address {}
+  address {
+    dns_name = "vip.example.com"
+    role     = "vip"
+  }
+}
+
+output "cluster_ips" {
+  value = netbox_available_ip_addresses.cluster.ip_addresses[*].ip_address
+}
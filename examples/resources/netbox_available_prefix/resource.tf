@@ -7,3 +7,32 @@ resource "netbox_available_prefix" "test" {
   prefix_length    = 25
   status           = "active"
 }
+
+# Recreating this resource, e.g. after its state was lost, adopts the prefix
+# carrying the allocation key instead of allocating a different one.
+resource "netbox_available_prefix" "k8s_pods" {
+  parent_prefix_id     = data.netbox_prefix.test.id
+  prefix_length        = 26
+  status               = "active"
+  allocation_key       = "k8s-prod-pods"
+  allocation_key_field = "cf_allocation_key"
+}
+
+# Allocates from the first regional pool with enough space available.
+data "netbox_ipam_role" "pool" {
+  name = "eu-pool"
+}
+
+resource "netbox_available_prefix" "regional" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_prefix_filter {
+    name  = "role_id"
+    value = data.netbox_ipam_role.pool.id
+  }
+}
+
+output "regional_parent_prefix_id" {
+  value = netbox_available_prefix.regional.parent_prefix_id
+}
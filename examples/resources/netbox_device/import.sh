@@ -0,0 +1,3 @@
+# Devices can be imported by ID, by name or by name and site slug, separated by @
+terraform import netbox_device.example 1
+terraform import netbox_device.example my-device@my-site
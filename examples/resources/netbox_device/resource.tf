@@ -26,3 +26,25 @@ resource "netbox_device" "test" {
     "setting_b" = 42
   })
 }
+
+resource "netbox_rack" "test" {
+  name     = "test"
+  site_id  = netbox_site.test.id
+  status   = "active"
+  width    = 19
+  u_height = 42
+}
+
+# Places the device at the lowest two free units on the front of the rack
+resource "netbox_device" "racked" {
+  name           = "racked"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+  rack_id        = netbox_rack.test.id
+  rack_face      = "front"
+  rack_position_auto {
+    height = 2
+    prefer = "bottom"
+  }
+}
@@ -0,0 +1,5 @@
+# Device interfaces can be imported by ID or by device and interface name, separated by /
+# The device can be qualified with its site slug as well
+terraform import netbox_device_interface.example 1
+terraform import netbox_device_interface.example my-device/eth0
+terraform import netbox_device_interface.example my-device@my-site/GigabitEthernet0/1
@@ -0,0 +1,27 @@
+resource "netbox_manufacturer" "cisco" {
+  name = "Cisco"
+}
+
+# The definition is taken from a checkout of
+# https://github.com/netbox-community/devicetype-library
+resource "netbox_device_type_from_library" "c9300_48p" {
+  yaml = file("${path.module}/devicetype-library/device-types/Cisco/C9300-48P.yaml")
+
+  depends_on = [netbox_manufacturer.cisco]
+}
+
+# The manufacturer can also be given explicitly
+resource "netbox_device_type_from_library" "custom" {
+  manufacturer_id = netbox_manufacturer.cisco.id
+  yaml            = <<-EOT
+    manufacturer: Cisco
+    model: Custom Switch
+    slug: cisco-custom-switch
+    u_height: 1
+    interfaces:
+      - name: GigabitEthernet0/1
+        type: 1000base-t
+      - name: GigabitEthernet0/2
+        type: 1000base-t
+  EOT
+}
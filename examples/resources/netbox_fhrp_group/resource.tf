@@ -0,0 +1,16 @@
+resource "netbox_fhrp_group" "gateway" {
+  protocol  = "vrrp3"
+  group_id  = 10
+  name      = "gateway"
+  auth_type = "plaintext"
+  auth_key  = "secret"
+}
+
+# The virtual IP of the FHRP group
+resource "netbox_ip_address" "vip" {
+  ip_address   = "10.0.0.1/24"
+  status       = "active"
+  role         = "vrrp"
+  object_type  = "ipam.fhrpgroup"
+  interface_id = netbox_fhrp_group.gateway.id
+}
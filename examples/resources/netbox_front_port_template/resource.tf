@@ -0,0 +1,26 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  slug            = "test-model"
+  part_number     = "test-part-number"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_rear_port_template" "test" {
+  name           = "rear1"
+  device_type_id = netbox_device_type.test.id
+  type           = "mpo"
+  positions      = 12
+}
+
+resource "netbox_front_port_template" "test" {
+  name                  = "front1"
+  description           = "front1 description"
+  device_type_id        = netbox_device_type.test.id
+  type                  = "lc"
+  rear_port_template_id = netbox_rear_port_template.test.id
+  rear_port_position    = 1
+}
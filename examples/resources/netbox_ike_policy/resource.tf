@@ -0,0 +1,6 @@
+resource "netbox_ike_policy" "site_to_site" {
+  name          = "site-to-site"
+  version       = 2
+  proposal_ids  = [netbox_ike_proposal.aes256.id]
+  preshared_key = var.ike_preshared_key
+}
@@ -0,0 +1,8 @@
+resource "netbox_ike_proposal" "aes256" {
+  name                     = "ike-aes256-sha256-dh14"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+  sa_lifetime              = 28800
+}
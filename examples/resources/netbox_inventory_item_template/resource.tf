@@ -0,0 +1,18 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  slug            = "test-model"
+  part_number     = "test-part-number"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_inventory_item_template" "test" {
+  name            = "fan1"
+  description     = "fan1 description"
+  device_type_id  = netbox_device_type.test.id
+  manufacturer_id = netbox_manufacturer.test.id
+  part_id         = "FAN-1"
+}
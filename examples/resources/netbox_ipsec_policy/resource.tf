@@ -0,0 +1,5 @@
+resource "netbox_ipsec_policy" "site_to_site" {
+  name         = "site-to-site"
+  proposal_ids = [netbox_ipsec_proposal.aes256.id]
+  pfs_group    = 14
+}
@@ -0,0 +1,6 @@
+resource "netbox_ipsec_profile" "site_to_site" {
+  name            = "site-to-site"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.site_to_site.id
+  ipsec_policy_id = netbox_ipsec_policy.site_to_site.id
+}
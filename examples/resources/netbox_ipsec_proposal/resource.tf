@@ -0,0 +1,6 @@
+resource "netbox_ipsec_proposal" "aes256" {
+  name                     = "esp-aes256-sha256"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  sa_lifetime_seconds      = 3600
+}
@@ -0,0 +1,11 @@
+resource "netbox_route_target" "evpn" {
+  name = "65000:10100"
+}
+
+resource "netbox_l2vpn" "evpn" {
+  name              = "customer-a"
+  type              = "vxlan-evpn"
+  identifier        = 10100
+  import_target_ids = [netbox_route_target.evpn.id]
+  export_target_ids = [netbox_route_target.evpn.id]
+}
@@ -0,0 +1,9 @@
+resource "netbox_l2vpn_termination" "vlan" {
+  l2vpn_id = netbox_l2vpn.evpn.id
+  vlan_id  = netbox_vlan.customer_a.id
+}
+
+resource "netbox_l2vpn_termination" "uplink" {
+  l2vpn_id            = netbox_l2vpn.evpn.id
+  device_interface_id = netbox_device_interface.uplink.id
+}
@@ -0,0 +1,17 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  slug            = "test-model"
+  part_number     = "test-part-number"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_module_bay_template" "test" {
+  name           = "slot1"
+  description    = "slot1 description"
+  device_type_id = netbox_device_type.test.id
+  position       = "1"
+}
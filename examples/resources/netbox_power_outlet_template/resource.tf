@@ -0,0 +1,25 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  slug            = "test-model"
+  part_number     = "test-part-number"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_power_port_template" "test" {
+  name           = "input"
+  device_type_id = netbox_device_type.test.id
+  type           = "iec-60320-c20"
+}
+
+resource "netbox_power_outlet_template" "test" {
+  name                   = "outlet1"
+  description            = "outlet1 description"
+  device_type_id         = netbox_device_type.test.id
+  type                   = "iec-60320-c13"
+  power_port_template_id = netbox_power_port_template.test.id
+  feed_leg               = "A"
+}
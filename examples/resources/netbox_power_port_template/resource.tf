@@ -0,0 +1,19 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  slug            = "test-model"
+  part_number     = "test-part-number"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_power_port_template" "test" {
+  name           = "PSU1"
+  description    = "PSU1 description"
+  device_type_id = netbox_device_type.test.id
+  type           = "iec-60320-c14"
+  maximum_draw   = 500
+  allocated_draw = 250
+}
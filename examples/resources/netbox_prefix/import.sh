@@ -0,0 +1,4 @@
+# Prefixes can be imported by ID, by VRF name and prefix, separated by /, or by prefix for prefixes without VRF
+terraform import netbox_prefix.example 1
+terraform import netbox_prefix.example my-vrf/10.0.0.0/24
+terraform import netbox_prefix.example 10.0.0.0/24
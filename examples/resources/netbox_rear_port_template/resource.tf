@@ -0,0 +1,18 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  slug            = "test-model"
+  part_number     = "test-part-number"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_rear_port_template" "test" {
+  name           = "rear1"
+  description    = "rear1 description"
+  device_type_id = netbox_device_type.test.id
+  type           = "mpo"
+  positions      = 12
+}
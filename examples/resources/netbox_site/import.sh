@@ -0,0 +1,3 @@
+# Sites can be imported by ID or slug
+terraform import netbox_site.example 1
+terraform import netbox_site.example my-site
@@ -0,0 +1,3 @@
+# VLANs can be imported by ID or by VLAN group slug or name and VLAN ID, separated by /
+terraform import netbox_vlan.example 1
+terraform import netbox_vlan.example my-vlan-group/100
@@ -12,3 +12,11 @@ resource "netbox_vpn_tunnel" "test" {
   tunnel_id   = 3
   tenant_id   = 2
 }
+
+resource "netbox_vpn_tunnel" "ipsec" {
+  name             = "my-ipsec-tunnel"
+  encapsulation    = "ipsec-tunnel"
+  status           = "active"
+  tunnel_group_id  = netbox_vpn_tunnel_group.test.id
+  ipsec_profile_id = netbox_ipsec_profile.site_to_site.id
+}
@@ -2,3 +2,14 @@ resource "netbox_vrf" "cust_a_prod" {
   name = "cust-a-prod"
   tags = ["customer-a", "prod"]
 }
+
+resource "netbox_route_target" "cust_b" {
+  name = "65000:200"
+}
+
+resource "netbox_vrf" "cust_b_prod" {
+  name              = "cust-b-prod"
+  rd                = "65000:200"
+  import_target_ids = [netbox_route_target.cust_b.id]
+  export_target_ids = [netbox_route_target.cust_b.id]
+}
@@ -0,0 +1,12 @@
+resource "netbox_wireless_lan_group" "campus" {
+  name = "Campus"
+}
+
+resource "netbox_wireless_lan" "corp" {
+  ssid        = "corp"
+  group_id    = netbox_wireless_lan_group.campus.id
+  site_id     = 1
+  auth_type   = "wpa-personal"
+  auth_cipher = "aes"
+  auth_psk    = "changeme"
+}
@@ -0,0 +1,9 @@
+resource "netbox_wireless_lan_group" "campus" {
+  name        = "Campus"
+  description = "Campus wireless networks"
+}
+
+resource "netbox_wireless_lan_group" "guest" {
+  name      = "Guest"
+  parent_id = netbox_wireless_lan_group.campus.id
+}
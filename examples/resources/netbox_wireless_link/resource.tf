@@ -0,0 +1,7 @@
+resource "netbox_wireless_link" "backhaul" {
+  interface_a_id = netbox_device_interface.building_a.id
+  interface_b_id = netbox_device_interface.building_b.id
+  ssid           = "backhaul"
+  distance       = 1.2
+  distance_unit  = "km"
+}
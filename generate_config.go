@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/e-breuninger/terraform-provider-netbox/netbox"
+)
+
+const generateConfigCommand = "generate-config"
+
+// filterFlags collects the repeatable -filter flag.
+type filterFlags map[string]url.Values
+
+func (f filterFlags) String() string {
+	return ""
+}
+
+// Set parses a filter of the form [<resource type>:]<key>=<value>.
+func (f filterFlags) Set(value string) error {
+	resourceType := ""
+	if before, after, found := strings.Cut(value, ":"); found && !strings.Contains(before, "=") {
+		resourceType, value = before, after
+	}
+	key, filterValue, found := strings.Cut(value, "=")
+	if !found || key == "" {
+		return fmt.Errorf("invalid filter %q, expected [<resource type>:]<key>=<value>", value)
+	}
+	if f[resourceType] == nil {
+		f[resourceType] = url.Values{}
+	}
+	f[resourceType].Add(key, filterValue)
+	return nil
+}
+
+// generateConfig runs the generate-config command, which writes Terraform
+// configuration for existing Netbox objects, and returns the exit code.
+func generateConfig(args []string) int {
+	flags := flag.NewFlagSet(generateConfigCommand, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), `Usage: terraform-provider-netbox %s [options]
+
+Writes import and resource blocks for existing Netbox objects. The connection
+to Netbox is configured with the same environment variables as the provider,
+e.g. NETBOX_SERVER_URL and NETBOX_API_TOKEN.
+
+Options:
+`, generateConfigCommand)
+		flags.PrintDefaults()
+		fmt.Fprintf(flags.Output(), "\nSupported resource types: %s\n", strings.Join(netbox.ConfigGenResourceTypes(), ", "))
+	}
+
+	types := flags.String("types", "", "comma-separated list of resource types to export, defaults to all supported types")
+	out := flags.String("out", "", "file to write the configuration to, defaults to stdout")
+	filters := filterFlags{}
+	flags.Var(filters, "filter", "filter of the form [<resource type>:]<key>=<value> passed to the list endpoints of the API, can be given multiple times. Filters without resource type apply to all resource types")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	opts := netbox.GenerateConfigOptions{
+		Filters: filters,
+	}
+	if *types != "" {
+		for _, t := range strings.Split(*types, ",") {
+			opts.ResourceTypes = append(opts.ResourceTypes, strings.TrimSpace(t))
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := netbox.GenerateConfig(context.Background(), w, opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
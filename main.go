@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"os"
 
 	"github.com/e-breuninger/terraform-provider-netbox/netbox"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,10 @@ import (
 //go:generate go run github.com/fbreckle/terraform-plugin-docs/cmd/tfplugindocs
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == generateConfigCommand {
+		os.Exit(generateConfig(os.Args[2:]))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -0,0 +1,185 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	allocationKeyKey      = "allocation_key"
+	allocationKeyFieldKey = "allocation_key_field"
+)
+
+var allocationKeySchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "A key identifying the allocation. Before a new object is allocated, an existing object carrying this key is looked up and adopted, so that recreating the resource, e.g. after it was tainted or its state was lost, yields the same object.",
+}
+
+var allocationKeyFieldSchema = &schema.Schema{
+	Type:     schema.TypeString,
+	Optional: true,
+	Default:  "description",
+	ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+		field := v.(string)
+		if field == "description" || field == "tag" || (strings.HasPrefix(field, customFieldFilterPrefix) && len(field) > len(customFieldFilterPrefix)) {
+			return nil, nil
+		}
+		return nil, []error{fmt.Errorf("expected %s to be one of `description`, `tag` or `%s<custom field name>`, got %s", k, customFieldFilterPrefix, field)}
+	},
+	Description: "Where the allocation key is stored. Either `description`, which takes the place of the description, `tag`, which adds the existing tag named like the key, or `" + customFieldFilterPrefix + "<name>`, which stores the key in the text custom field `<name>`. Defaults to `description`.",
+}
+
+// getAllocationKey returns the allocation key and the field it is stored in.
+// The key is empty if the resource has no allocation key.
+func getAllocationKey(d interface {
+	GetOk(string) (interface{}, bool)
+}) (string, string) {
+	key, ok := d.GetOk(allocationKeyKey)
+	if !ok {
+		return "", ""
+	}
+	field, ok := d.GetOk(allocationKeyFieldKey)
+	if !ok {
+		field = "description"
+	}
+	return key.(string), field.(string)
+}
+
+// findAllocatedObject looks up the object carrying the allocation key of the
+// resource among the objects of the given list endpoint matching query. The
+// result is nil if there is no such object or no allocation key. Objects not
+// accepted by filter are ignored.
+func (s *providerState) findAllocatedObject(ctx context.Context, d *schema.ResourceData, path string, query url.Values, filter func(map[string]interface{}) bool) (map[string]interface{}, error) {
+	key, field := getAllocationKey(d)
+	if key == "" {
+		return nil, nil
+	}
+
+	query = cloneQuery(query)
+	switch {
+	case field == "description":
+		query.Set("description", key)
+	case field == "tag":
+		tag, ok := s.tagCache[key]
+		if !ok {
+			var err error
+			tag, err = findTag(s.NetBoxAPI, key)
+			if err != nil {
+				return nil, err
+			}
+		}
+		query.Set("tag", *tag.Slug)
+	default:
+		query.Set(field, key)
+	}
+
+	results, err := s.listRaw(ctx, path, query, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var found map[string]interface{}
+	for _, r := range results {
+		if filter != nil && !filter(r) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple objects carry the allocation key %q", key)
+		}
+		found = r
+	}
+	return found, nil
+}
+
+func cloneQuery(query url.Values) url.Values {
+	clone := url.Values{}
+	for k, v := range query {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
+
+// getRawID returns the ID of an object returned by one of the raw API
+// functions.
+func getRawID(object map[string]interface{}) (int64, error) {
+	return strconv.ParseInt(fmt.Sprint(object["id"]), 10, 64)
+}
+
+// setAllocationKey stores the allocation key of the resource in the fields
+// of a request body.
+func (s *providerState) setAllocationKey(d *schema.ResourceData, description *string, tags *[]*models.NestedTag, customFields *interface{}) error {
+	key, field := getAllocationKey(d)
+	if key == "" {
+		return nil
+	}
+
+	switch {
+	case field == "description":
+		*description = key
+	case field == "tag":
+		for _, t := range *tags {
+			if t.Name != nil && *t.Name == key {
+				return nil
+			}
+		}
+		tag, err := getNestedTagListFromResourceDataSet(s, schema.NewSet(schema.HashString, []interface{}{key}))
+		if err != nil {
+			return err
+		}
+		*tags = append(*tags, tag...)
+	default:
+		cf, _ := (*customFields).(map[string]interface{})
+		if cf == nil {
+			cf = map[string]interface{}{}
+		}
+		cf[strings.TrimPrefix(field, customFieldFilterPrefix)] = key
+		*customFields = cf
+	}
+	return nil
+}
+
+// stripAllocationKey removes the allocation key from the attributes read
+// from the API, so it does not show up as a difference to the configuration.
+func stripAllocationKey(d *schema.ResourceData) {
+	key, field := getAllocationKey(d)
+	if key == "" {
+		return
+	}
+
+	switch {
+	case field == "description":
+		if d.Get("description").(string) == key {
+			d.Set("description", "")
+		}
+	case field == "tag":
+		for _, k := range []string{tagsKey, tagsAllKey} {
+			if tags, ok := d.Get(k).(*schema.Set); ok && tags.Contains(key) {
+				tags.Remove(key)
+				d.Set(k, tags.List())
+			}
+		}
+	default:
+		if cf, ok := d.Get(customFieldsKey).(map[string]interface{}); ok {
+			if _, ok := cf[strings.TrimPrefix(field, customFieldFilterPrefix)]; ok {
+				delete(cf, strings.TrimPrefix(field, customFieldFilterPrefix))
+				d.Set(customFieldsKey, cf)
+			}
+		}
+	}
+}
+
+// allocationKeyCustomizeDiff rejects a description if the allocation key is
+// stored in its place.
+func allocationKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if key, field := getAllocationKey(d); key != "" && field == "description" && d.Get("description").(string) != "" {
+		return fmt.Errorf("description cannot be set if the allocation key is stored in the description")
+	}
+	return nil
+}
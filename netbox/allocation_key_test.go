@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocationKey(t *testing.T) {
+	s := resourceNetboxAvailablePrefix().Schema
+	s[tagsAllKey] = tagsAllSchema
+	api := &providerState{}
+
+	for _, tt := range []struct {
+		name                 string
+		field                string
+		expectedDescription  string
+		expectedCustomFields interface{}
+	}{
+		{
+			name:                "Description",
+			field:               "description",
+			expectedDescription: "key",
+		},
+		{
+			name:                 "CustomField",
+			field:                "cf_allocation",
+			expectedCustomFields: map[string]interface{}{"allocation": "key"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+				"allocation_key":       "key",
+				"allocation_key_field": tt.field,
+			})
+
+			var description string
+			var customFields interface{}
+			assert.NoError(t, api.setAllocationKey(d, &description, nil, &customFields))
+			assert.Equal(t, tt.expectedDescription, description)
+			assert.Equal(t, tt.expectedCustomFields, customFields)
+
+			d.Set("description", "key")
+			d.Set(customFieldsKey, map[string]interface{}{"allocation": "key", "other": "value"})
+			stripAllocationKey(d)
+			if tt.field == "description" {
+				assert.Equal(t, "", d.Get("description"))
+			} else {
+				assert.Equal(t, "key", d.Get("description"))
+				assert.Equal(t, map[string]interface{}{"other": "value"}, d.Get(customFieldsKey))
+			}
+		})
+	}
+}
+
+func TestAllocationKeyNotSet(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxPrefix().Schema, map[string]interface{}{
+		"description": "description",
+	})
+
+	description := "description"
+	assert.NoError(t, (&providerState{}).setAllocationKey(d, &description, nil, nil))
+	assert.Equal(t, "description", description)
+
+	stripAllocationKey(d)
+	assert.Equal(t, "description", d.Get("description"))
+}
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// rawListPage is a single page of results returned by any list endpoint of
+// the API.
+type rawListPage struct {
+	Count   int64                    `json:"count"`
+	Next    *string                  `json:"next"`
+	Results []map[string]interface{} `json:"results"`
+}
+
+// normalizeAPIPath turns an endpoint given as e.g. `dcim/sites` or
+// `/api/dcim/sites/` into the path expected by the API client.
+func normalizeAPIPath(path string) string {
+	path = strings.Trim(path, "/")
+	path = strings.TrimPrefix(path, "api/")
+	return "/" + path + "/"
+}
+
+// getRaw sends a GET request to an arbitrary endpoint of the API and decodes
+// the JSON response into result. Numbers are decoded as json.Number.
+func (s *providerState) getRaw(ctx context.Context, path string, query url.Values, result interface{}) error {
+	return s.doRaw(ctx, "GET", path, query, nil, result)
+}
+
+// postRaw sends a POST request with the given body encoded as JSON to an
+// arbitrary endpoint of the API and decodes the JSON response into result.
+func (s *providerState) postRaw(ctx context.Context, path string, body interface{}, result interface{}) error {
+	return s.doRaw(ctx, "POST", path, nil, body, result)
+}
+
+// putRaw sends a PUT request with the given body encoded as JSON to an
+// arbitrary endpoint of the API and decodes the JSON response into result.
+func (s *providerState) putRaw(ctx context.Context, path string, body interface{}, result interface{}) error {
+	return s.doRaw(ctx, "PUT", path, nil, body, result)
+}
+
+// patchRaw sends a PATCH request with the given body encoded as JSON to an
+// arbitrary endpoint of the API and decodes the JSON response into result.
+func (s *providerState) patchRaw(ctx context.Context, path string, body interface{}, result interface{}) error {
+	return s.doRaw(ctx, "PATCH", path, nil, body, result)
+}
+
+// deleteRaw sends a DELETE request to an arbitrary endpoint of the API.
+func (s *providerState) deleteRaw(ctx context.Context, path string) error {
+	return s.doRaw(ctx, "DELETE", path, nil, nil, nil)
+}
+
+// isRawNotFound reports whether err is the error returned by the raw API
+// functions for a missing object.
+func isRawNotFound(err error) bool {
+	var apiErr *runtime.APIError
+	return errors.As(err, &apiErr) && apiErr.IsCode(404)
+}
+
+func (s *providerState) doRaw(ctx context.Context, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	op := &runtime.ClientOperation{
+		ID:                 "raw_" + strings.ToLower(method),
+		Method:             method,
+		PathPattern:        normalizeAPIPath(path),
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params: runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, _ strfmt.Registry) error {
+			for key, values := range query {
+				if err := req.SetQueryParam(key, values...); err != nil {
+					return err
+				}
+			}
+			if body != nil {
+				return req.SetBodyParam(body)
+			}
+			return nil
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if resp.Code()/100 != 2 {
+				body, _ := io.ReadAll(resp.Body())
+				return nil, runtime.NewAPIError(method+" "+path, string(body), resp.Code())
+			}
+			if result == nil {
+				return nil, nil
+			}
+			return nil, consumer.Consume(resp.Body(), result)
+		}),
+		Context: ctx,
+	}
+
+	_, err := s.Transport.Submit(op)
+	return err
+}
+
+// listRaw fetches all objects of an arbitrary list endpoint of the API
+// matching the given query, following pagination.
+func (s *providerState) listRaw(ctx context.Context, path string, query url.Values, limit, pageSize int64) ([]map[string]interface{}, error) {
+	return listAll(limit, pageSize, func(limit, offset *int64) ([]map[string]interface{}, bool, error) {
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		if limit != nil {
+			pageQuery.Set("limit", strconv.FormatInt(*limit, 10))
+		}
+		if offset != nil {
+			pageQuery.Set("offset", strconv.FormatInt(*offset, 10))
+		}
+
+		var page rawListPage
+		if err := s.getRaw(ctx, path, pageQuery, &page); err != nil {
+			return nil, false, err
+		}
+		return page.Results, page.Next != nil, nil
+	})
+}
@@ -0,0 +1,18 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAPIPath(t *testing.T) {
+	for input, expected := range map[string]string{
+		"dcim/cables":       "/dcim/cables/",
+		"/dcim/cables/":     "/dcim/cables/",
+		"api/dcim/cables":   "/dcim/cables/",
+		"/api/extras/tags/": "/extras/tags/",
+	} {
+		assert.Equal(t, expected, normalizeAPIPath(input), input)
+	}
+}
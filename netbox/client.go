@@ -20,6 +20,11 @@ type Config struct {
 	RequestTimeout              int
 	StripTrailingSlashesFromURL bool
 	CACertFile                  string
+	MaxRetries                  int
+	RetryWaitMin                int
+	RetryWaitMax                int
+	MaxRequestsPerSecond        float64
+	MaxConcurrentRequests       int
 }
 
 // customHeaderTransport is a transport that adds the specified headers on
@@ -75,9 +80,29 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 		}
 	}
 
+	// the request timeout is enforced for every single attempt by the retry
+	// transport, so it is not set on the http client
+	retry := retryTransport{
+		original:   trans,
+		maxRetries: cfg.MaxRetries,
+		waitMin:    time.Second * time.Duration(cfg.RetryWaitMin),
+		waitMax:    time.Second * time.Duration(cfg.RetryWaitMax),
+		timeout:    time.Second * time.Duration(cfg.RequestTimeout),
+	}
+
+	// the request limits apply to every single attempt, so retries are
+	// throttled as well and no concurrency slot is held while waiting between
+	// attempts
+	if cfg.MaxRequestsPerSecond > 0 || cfg.MaxConcurrentRequests > 0 {
+		log.WithFields(log.Fields{
+			"max_requests_per_second": cfg.MaxRequestsPerSecond,
+			"max_concurrent_requests": cfg.MaxConcurrentRequests,
+		}).Debug("Limiting requests to Netbox")
+
+		retry.limiter = newRequestLimiter(cfg.MaxRequestsPerSecond, cfg.MaxConcurrentRequests)
+	}
 	httpClient := &http.Client{
-		Transport: trans,
-		Timeout:   time.Second * time.Duration(cfg.RequestTimeout),
+		Transport: retry,
 	}
 
 	transport := httptransport.NewWithClient(parsedURL.Host, parsedURL.Path+netboxclient.DefaultBasePath, desiredRuntimeClientSchemes, httpClient)
@@ -0,0 +1,56 @@
+package netbox
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter limits the rate and the number of concurrent requests sent
+// to Netbox. Requests exceeding either limit are queued until they are allowed
+// to proceed.
+type requestLimiter struct {
+	// limiter is a token bucket limiting the requests per second, nil if unlimited
+	limiter *rate.Limiter
+
+	// slots holds one element per in-flight request, nil if unlimited
+	slots chan struct{}
+}
+
+func newRequestLimiter(requestsPerSecond float64, concurrentRequests int) *requestLimiter {
+	l := &requestLimiter{}
+	if requestsPerSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(1, int(requestsPerSecond)))
+	}
+	if concurrentRequests > 0 {
+		l.slots = make(chan struct{}, concurrentRequests)
+	}
+	return l
+}
+
+// acquire waits until a request is allowed by the concurrency and rate limits.
+// The returned function frees the concurrency slot of the request again and
+// may be called more than once.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() {
+			once.Do(func() { <-l.slots })
+		}
+	}
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLimitedTransport(requestsPerSecond float64, concurrentRequests int, timeout time.Duration) retryTransport {
+	return retryTransport{
+		original: http.DefaultTransport,
+		limiter:  newRequestLimiter(requestsPerSecond, concurrentRequests),
+		timeout:  timeout,
+	}
+}
+
+func TestRequestLimiterLimitsConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt64(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt64(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt64(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt64(&inFlight, -1)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestLimitedTransport(0, 2, 0)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(ts.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight, int64(2))
+}
+
+func TestRequestLimiterLimitsRequestsPerSecond(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestLimitedTransport(20, 0, 0)}
+
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		resp, err := client.Get(ts.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	}
+
+	// the bucket starts with 20 tokens, the remaining 5 requests need 250ms
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestRequestLimiterWaitDoesNotCountAgainstTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	// every request takes half of the timeout, so the last ones are queued for
+	// longer than the timeout before they are sent
+	client := &http.Client{Transport: newTestLimitedTransport(0, 1, 100*time.Millisecond)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPost, ts.URL, nil)
+			resp, err := client.Do(req)
+			if assert.NoError(t, err) {
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+}
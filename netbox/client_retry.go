@@ -0,0 +1,199 @@
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// retryableStatusCodes are the HTTP status codes returned by Netbox (or a proxy
+// in front of it) that indicate a transient error.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// idempotentMethods are the HTTP methods that are safe to send again after a
+// failed attempt.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+}
+
+// retryTransport is a transport that retries requests failing with a
+// transient error, waiting with exponential backoff between attempts.
+// Every attempt is subject to the request limits, if any, and bounded by the
+// request timeout once it is allowed to proceed.
+type retryTransport struct {
+	original   http.RoundTripper
+	limiter    *requestLimiter
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+	timeout    time.Duration
+}
+
+// doneOnCloseBody cancels the context of a single attempt and frees its
+// concurrency slot once the caller is done reading the response body.
+type doneOnCloseBody struct {
+	io.ReadCloser
+	done func()
+}
+
+func (b doneOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+	return err
+}
+
+// RoundTrip sends the request and retries it while the failure is transient
+// and the request can be safely repeated.
+func (t retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// every attempt needs a fresh copy of the request body
+	getBody := r.GetBody
+	if r.Body != nil && r.Body != http.NoBody {
+		if getBody == nil {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				r.Body.Close()
+				return nil, err
+			}
+			getBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+		r.Body.Close()
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(r, getBody)
+
+		if attempt >= t.maxRetries || !shouldRetry(r.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		log.WithFields(log.Fields{
+			"method":  r.Method,
+			"url":     r.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}).Debug("Retrying request to Netbox after transient error")
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (t retryTransport) attempt(r *http.Request, getBody func() (io.ReadCloser, error)) (*http.Response, error) {
+	// the time spent waiting for the request limits does not count against
+	// the request timeout
+	release := func() {}
+	if t.limiter != nil {
+		var err error
+		release, err = t.limiter.acquire(r.Context())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(r.Context(), t.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(r.Context())
+	}
+
+	req := r.Clone(ctx)
+	if getBody != nil {
+		body, err := getBody()
+		if err != nil {
+			cancel()
+			release()
+			return nil, err
+		}
+		req.Body = body
+	}
+
+	resp, err := t.original.RoundTrip(req)
+	if err != nil {
+		cancel()
+		release()
+		return nil, err
+	}
+	resp.Body = doneOnCloseBody{ReadCloser: resp.Body, done: func() {
+		cancel()
+		release()
+	}}
+	return resp, nil
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header sent by the server takes precedence over the exponential backoff, but
+// neither may exceed the maximum wait time.
+func (t retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(max(wait, t.waitMin), t.waitMax)
+		}
+	}
+
+	wait := t.waitMin
+	for i := 0; i < attempt && wait < t.waitMax; i++ {
+		wait *= 2
+	}
+	return min(wait, t.waitMax)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// shouldRetry decides whether a failed attempt may be repeated. Idempotent
+// requests are retried on connection errors and transient status codes. All
+// other requests (i.e. POST) are only retried if the connection was refused,
+// since in that case the request was never received by Netbox.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return true
+		}
+		return idempotentMethods[method]
+	}
+
+	return idempotentMethods[method] && retryableStatusCodes[resp.StatusCode]
+}
@@ -0,0 +1,168 @@
+package netbox
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRetryTransport(maxRetries int) retryTransport {
+	return retryTransport{
+		original:   http.DefaultTransport,
+		maxRetries: maxRetries,
+		waitMin:    time.Millisecond,
+		waitMax:    10 * time.Millisecond,
+	}
+}
+
+func TestRetryTransportRetriesTransientErrors(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"test"}`, string(body))
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+	req, _ := http.NewRequest(http.MethodPatch, ts.URL, strings.NewReader(`{"name":"test"}`))
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTransportGivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(2)}
+	resp, err := client.Get(ts.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTransportDoesNotRetryPost(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+	resp, err := client.Post(ts.URL, "application/json", strings.NewReader("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryTransportDoesNotRetryClientErrors(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+	resp, err := client.Get(ts.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestRetryTransportRetriesPostOnConnectionRefused(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := ts.URL
+	ts.Close()
+
+	calls := 0
+	transport := newTestRetryTransport(2)
+	transport.original = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	client := &http.Client{Transport: transport}
+	_, err := client.Post(url, "application/json", strings.NewReader("{}"))
+	assert.Error(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := retryTransport{
+		waitMin: time.Second,
+		waitMax: 5 * time.Second,
+	}
+
+	for _, tt := range []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{
+			name:     "FirstAttempt",
+			attempt:  0,
+			expected: time.Second,
+		},
+		{
+			name:     "ThirdAttempt",
+			attempt:  2,
+			expected: 4 * time.Second,
+		},
+		{
+			name:     "CappedAtMaximum",
+			attempt:  10,
+			expected: 5 * time.Second,
+		},
+		{
+			name:       "RetryAfterSeconds",
+			attempt:    0,
+			retryAfter: "3",
+			expected:   3 * time.Second,
+		},
+		{
+			name:       "RetryAfterCappedAtMaximum",
+			attempt:    0,
+			retryAfter: "120",
+			expected:   5 * time.Second,
+		},
+		{
+			name:       "RetryAfterInvalid",
+			attempt:    1,
+			retryAfter: "soon",
+			expected:   2 * time.Second,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			assert.Equal(t, tt.expected, transport.backoff(tt.attempt, resp))
+		})
+	}
+}
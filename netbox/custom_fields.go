@@ -1,7 +1,17 @@
 package netbox
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	log "github.com/sirupsen/logrus"
 )
 
 const customFieldsKey = "custom_fields"
@@ -14,12 +24,327 @@ var customFieldsSchema = &schema.Schema{
 		Type:    schema.TypeString,
 		Default: nil,
 	},
+	DiffSuppressFunc: customFieldValueDiffSuppress,
+	Description:      "Map of custom field names to their values. Values are always given as strings and are converted to the type of the respective custom field: `integer`, `decimal` and `boolean` fields take their string representation (e.g. `\"42\"`, `\"1.5\"`, `\"true\"`), `object` fields take the ID of the referenced object, `multiobject` and `multiselect` fields take a JSON-encoded list (e.g. `jsonencode([1, 2])`) and `json` fields take a JSON-encoded value. Values are validated against the custom field definitions during plan.",
+}
+
+// customFieldTypes holds the types of the custom field definitions loaded by
+// any provider instance by name. DiffSuppressFuncs have no access to the
+// provider state, so customFieldValueDiffSuppress looks the types up here.
+var customFieldTypes sync.Map
+
+// customFieldCache caches the custom field definitions of the Netbox instance
+// by name. The definitions are loaded on first use and kept for the remainder
+// of the provider run.
+type customFieldCache struct {
+	mu     sync.Mutex
+	loaded bool
+	fields map[string]*models.CustomField
+
+	// choiceSets holds the choice sets by ID, nil for choice sets that do not exist
+	choiceSets map[int64]*models.CustomFieldChoiceSet
+}
+
+// getCustomFieldDefinition returns the definition of the custom field with the
+// given name, or nil if no such custom field exists.
+func (s *providerState) getCustomFieldDefinition(name string) (*models.CustomField, error) {
+	c := &s.customFieldCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		fields, err := listAll(0, 0, func(limit, offset *int64) ([]*models.CustomField, bool, error) {
+			params := extras.NewExtrasCustomFieldsListParams().WithLimit(limit).WithOffset(offset)
+			res, err := s.Extras.ExtrasCustomFieldsList(params, nil)
+			if err != nil {
+				return nil, false, err
+			}
+			return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("API Error trying to retrieve custom fields from netbox: %w", err)
+		}
+
+		c.fields = make(map[string]*models.CustomField, len(fields))
+		for _, field := range fields {
+			c.fields[*field.Name] = field
+			customFieldTypes.Store(*field.Name, getCustomFieldType(field))
+		}
+		c.loaded = true
+	}
+
+	if field, ok := c.fields[name]; ok {
+		return field, nil
+	}
+
+	// the custom field might have been created after the cache was populated
+	params := extras.NewExtrasCustomFieldsListParams().WithName(&name)
+	res, err := s.Extras.ExtrasCustomFieldsList(params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("API Error trying to retrieve custom field %q from netbox: %w", name, err)
+	}
+	if len(res.GetPayload().Results) == 0 {
+		return nil, nil
+	}
+
+	field := res.GetPayload().Results[0]
+	c.fields[name] = field
+	customFieldTypes.Store(name, getCustomFieldType(field))
+	return field, nil
+}
+
+// forgetCustomFieldDefinition removes a custom field definition from the
+// cache, so that it is fetched again the next time it is used.
+func (s *providerState) forgetCustomFieldDefinition(name string) {
+	c := &s.customFieldCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.fields, name)
+	customFieldTypes.Delete(name)
+}
+
+func getCustomFieldType(field *models.CustomField) string {
+	if field == nil || field.Type == nil || field.Type.Value == nil {
+		return ""
+	}
+	return *field.Type.Value
+}
+
+// getCustomFieldsForAPI converts the string values of a custom_fields
+// attribute to the native types expected by the API.
+func (s *providerState) getCustomFieldsForAPI(cf interface{}) (map[string]interface{}, error) {
+	cfm, ok := cf.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	customFields := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		strValue, _ := value.(string)
+
+		field, err := s.getCustomFieldDefinition(name)
+		if err != nil {
+			return nil, err
+		}
+		if field == nil {
+			// let the API report the unknown custom field
+			customFields[name] = strValue
+			continue
+		}
+
+		customFields[name], err = customFieldValueToAPI(getCustomFieldType(field), strValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for custom field %q: %w", name, err)
+		}
+	}
+	return customFields, nil
+}
+
+// customFieldValueToAPI converts the string representation of a custom field
+// value to the native type of the custom field type.
+func customFieldValueToAPI(fieldType, value string) (interface{}, error) {
+	switch fieldType {
+	case models.CustomFieldTypeValueText, models.CustomFieldTypeValueLongtext:
+		return value, nil
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	switch fieldType {
+	case models.CustomFieldTypeValueInteger, models.CustomFieldTypeValueObject:
+		i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid integer", value)
+		}
+		return i, nil
+	case models.CustomFieldTypeValueDecimal:
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid decimal number", value)
+		}
+		return f, nil
+	case models.CustomFieldTypeValueBoolean:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", value)
+		}
+		return b, nil
+	case models.CustomFieldTypeValueJSON:
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			// plain strings are valid values for json custom fields as well
+			return value, nil
+		}
+		return decoded, nil
+	case models.CustomFieldTypeValueMultiselect:
+		var choices []string
+		if err := json.Unmarshal([]byte(value), &choices); err != nil {
+			for _, choice := range strings.Split(value, ",") {
+				choices = append(choices, strings.TrimSpace(choice))
+			}
+		}
+		return choices, nil
+	case models.CustomFieldTypeValueMultiobject:
+		var ids []int64
+		if err := json.Unmarshal([]byte(value), &ids); err != nil {
+			ids = nil
+			for _, id := range strings.Split(value, ",") {
+				i, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("%q is not a valid list of object IDs", value)
+				}
+				ids = append(ids, i)
+			}
+		}
+		return ids, nil
+	}
+
+	return value, nil
 }
 
-func getCustomFields(cf interface{}) map[string]interface{} {
+// getCustomFields converts the custom field values returned by the API to
+// their string representation, so they can be stored in a custom_fields
+// attribute.
+func (s *providerState) getCustomFields(cf interface{}) map[string]interface{} {
 	cfm, ok := cf.(map[string]interface{})
 	if !ok || len(cfm) == 0 {
 		return nil
 	}
-	return cfm
+
+	customFields := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		field, err := s.getCustomFieldDefinition(name)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"custom_field": name,
+			}).Warnf("Could not determine custom field type: %v", err)
+		}
+		customFields[name] = customFieldValueFromAPI(getCustomFieldType(field), value)
+	}
+	return customFields
+}
+
+// customFieldValueFromAPI returns the string representation of a custom field
+// value returned by the API. Empty values are returned as nil.
+func customFieldValueFromAPI(fieldType string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	switch fieldType {
+	case models.CustomFieldTypeValueObject:
+		if id, ok := getObjectID(value); ok {
+			return strconv.FormatInt(id, 10)
+		}
+	case models.CustomFieldTypeValueMultiobject:
+		if objects, ok := value.([]interface{}); ok {
+			ids := make([]int64, 0, len(objects))
+			for _, object := range objects {
+				if id, ok := getObjectID(object); ok {
+					ids = append(ids, id)
+				}
+			}
+			encoded, _ := json.Marshal(ids)
+			return string(encoded)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// getObjectID returns the ID of a nested object returned by the API.
+func getObjectID(object interface{}) (int64, bool) {
+	m, ok := object.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	switch id := m["id"].(type) {
+	case float64:
+		return int64(id), true
+	case json.Number:
+		i, err := id.Int64()
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// customFieldValueDiffSuppress suppresses differences between custom field
+// values that only differ in formatting, e.g. a decimal configured as "1.50"
+// is returned by the API as "1.5". The type of the custom field is taken from
+// the definitions loaded so far; values of unknown custom fields are only
+// compared as JSON.
+func customFieldValueDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	fieldType, _ := customFieldTypes.Load(strings.TrimPrefix(k, customFieldsKey+"."))
+	fieldTypeValue, _ := fieldType.(string)
+	return customFieldValuesEqual(fieldTypeValue, old, new)
+}
+
+// customFieldValuesEqual reports whether two string representations of a
+// value of the given custom field type are equivalent.
+func customFieldValuesEqual(fieldType, old, new string) bool {
+	if old == new {
+		return true
+	}
+
+	switch fieldType {
+	case models.CustomFieldTypeValueInteger, models.CustomFieldTypeValueDecimal:
+		oldNumber, err := strconv.ParseFloat(strings.TrimSpace(old), 64)
+		if err != nil {
+			return false
+		}
+		newNumber, err := strconv.ParseFloat(strings.TrimSpace(new), 64)
+		return err == nil && oldNumber == newNumber
+	case models.CustomFieldTypeValueBoolean:
+		oldBool, err := strconv.ParseBool(strings.TrimSpace(old))
+		if err != nil {
+			return false
+		}
+		newBool, err := strconv.ParseBool(strings.TrimSpace(new))
+		return err == nil && oldBool == newBool
+	case models.CustomFieldTypeValueMultiselect:
+		// the order of the selected choices has no meaning
+		return slices.Equal(sortedCustomFieldChoices(old), sortedCustomFieldChoices(new))
+	}
+
+	if !isJSONContainer(old) || !isJSONContainer(new) {
+		return false
+	}
+
+	equal, err := jsonSemanticCompare(old, new)
+	return err == nil && equal
+}
+
+// sortedCustomFieldChoices returns the choices of a multiselect custom field
+// value in sorted order.
+func sortedCustomFieldChoices(value string) []string {
+	decoded, _ := customFieldValueToAPI(models.CustomFieldTypeValueMultiselect, value)
+	choices, _ := decoded.([]string)
+	choices = slices.Clone(choices)
+	slices.Sort(choices)
+	return choices
+}
+
+func isJSONContainer(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[")
 }
@@ -0,0 +1,233 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	log "github.com/sirupsen/logrus"
+)
+
+// customFieldObjectTypes maps the resources supporting custom fields to the
+// Netbox object type their custom fields have to be assigned to.
+var customFieldObjectTypes = map[string]string{
+	"netbox_available_prefix":           "ipam.prefix",
+	"netbox_cable":                      "dcim.cable",
+	"netbox_circuit_termination":        "circuits.circuittermination",
+	"netbox_device":                     "dcim.device",
+	"netbox_device_bay":                 "dcim.devicebay",
+	"netbox_device_console_port":        "dcim.consoleport",
+	"netbox_device_console_server_port": "dcim.consoleserverport",
+	"netbox_device_front_port":          "dcim.frontport",
+	"netbox_device_module_bay":          "dcim.modulebay",
+	"netbox_device_power_outlet":        "dcim.poweroutlet",
+	"netbox_device_power_port":          "dcim.powerport",
+	"netbox_device_rear_port":           "dcim.rearport",
+	"netbox_fhrp_group":                 "ipam.fhrpgroup",
+	"netbox_ike_policy":                 "vpn.ikepolicy",
+	"netbox_ike_proposal":               "vpn.ikeproposal",
+	"netbox_inventory_item":             "dcim.inventoryitem",
+	"netbox_inventory_item_role":        "dcim.inventoryitemrole",
+	"netbox_ip_address":                 "ipam.ipaddress",
+	"netbox_ipsec_policy":               "vpn.ipsecpolicy",
+	"netbox_ipsec_profile":              "vpn.ipsecprofile",
+	"netbox_ipsec_proposal":             "vpn.ipsecproposal",
+	"netbox_l2vpn":                      "vpn.l2vpn",
+	"netbox_l2vpn_termination":          "vpn.l2vpntermination",
+	"netbox_location":                   "dcim.location",
+	"netbox_mac_address":                "dcim.macaddress",
+	"netbox_module":                     "dcim.module",
+	"netbox_module_type":                "dcim.moduletype",
+	"netbox_power_feed":                 "dcim.powerfeed",
+	"netbox_power_panel":                "dcim.powerpanel",
+	"netbox_prefix":                     "ipam.prefix",
+	"netbox_rack":                       "dcim.rack",
+	"netbox_service":                    "ipam.service",
+	"netbox_site":                       "dcim.site",
+	"netbox_virtual_chassis":            "dcim.virtualchassis",
+	"netbox_virtual_disk":               "virtualization.virtualdisk",
+	"netbox_virtual_machine":            "virtualization.virtualmachine",
+	"netbox_wireless_lan":               "wireless.wirelesslan",
+	"netbox_wireless_lan_group":         "wireless.wirelesslangroup",
+	"netbox_wireless_link":              "wireless.wirelesslink",
+}
+
+// customFieldsCustomDiff returns a custom diff function that validates the
+// custom_fields attribute of a resource of the given object type against the
+// custom field definitions, so that invalid values are reported during plan
+// instead of failing the apply. Custom fields that do not exist yet, e.g.
+// because they are created in the same apply, or whose definition cannot be
+// read are not validated and left to the API.
+func customFieldsCustomDiff(objectType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		if !diff.HasChange(customFieldsKey) || !diff.NewValueKnown(customFieldsKey) {
+			return nil
+		}
+		state := m.(*providerState)
+
+		cfm, ok := diff.Get(customFieldsKey).(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		var errs []error
+		for _, name := range sortedKeys(cfm) {
+			value, _ := cfm[name].(string)
+			if value == "" || value == unknownVariableValue {
+				continue
+			}
+
+			field, err := state.getCustomFieldDefinition(name)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"custom_field": name,
+				}).Debugf("Not validating custom field value: %v", err)
+				continue
+			}
+			if field == nil {
+				continue
+			}
+
+			if err := state.validateCustomFieldValue(field, objectType, value); err != nil {
+				errs = append(errs, fmt.Errorf("invalid value for custom field %q: %w", name, err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// unknownVariableValue is the placeholder of values in a diff that are only
+// known after apply.
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// validateCustomFieldValue checks the string representation of a custom field
+// value against the definition of the custom field.
+func (s *providerState) validateCustomFieldValue(field *models.CustomField, objectType, value string) error {
+	objectTypes := field.ObjectTypes
+	if len(objectTypes) == 0 {
+		objectTypes = field.ContentTypes
+	}
+	if objectTypes != nil && !slices.Contains(objectTypes, objectType) {
+		return fmt.Errorf("custom field is not assigned to object type %s", objectType)
+	}
+
+	fieldType := getCustomFieldType(field)
+	apiValue, err := customFieldValueToAPI(fieldType, value)
+	if err != nil {
+		return err
+	}
+
+	switch fieldType {
+	case models.CustomFieldTypeValueText, models.CustomFieldTypeValueLongtext, models.CustomFieldTypeValueURL:
+		if field.ValidationRegex == "" {
+			return nil
+		}
+		// regular expressions not supported by Go are left to the API
+		re, err := regexp.Compile(field.ValidationRegex)
+		if err == nil && !re.MatchString(value) {
+			return fmt.Errorf("%q does not match %q", value, field.ValidationRegex)
+		}
+	case models.CustomFieldTypeValueInteger:
+		return validateCustomFieldRange(field, float64(apiValue.(int64)))
+	case models.CustomFieldTypeValueDecimal:
+		return validateCustomFieldRange(field, apiValue.(float64))
+	case models.CustomFieldTypeValueDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return fmt.Errorf("%q is not a valid date in the format YYYY-MM-DD", value)
+		}
+	case models.CustomFieldTypeValueSelect:
+		return s.validateCustomFieldChoices(field, []string{value})
+	case models.CustomFieldTypeValueMultiselect:
+		return s.validateCustomFieldChoices(field, apiValue.([]string))
+	}
+	return nil
+}
+
+func validateCustomFieldRange(field *models.CustomField, value float64) error {
+	if field.ValidationMinimum != nil && value < float64(*field.ValidationMinimum) {
+		return fmt.Errorf("%v is less than the minimum of %d", value, *field.ValidationMinimum)
+	}
+	if field.ValidationMaximum != nil && value > float64(*field.ValidationMaximum) {
+		return fmt.Errorf("%v is greater than the maximum of %d", value, *field.ValidationMaximum)
+	}
+	return nil
+}
+
+// validateCustomFieldChoices checks that all values are choices of the choice
+// set of the custom field. Choice sets based on one of the predefined base
+// choices, as well as choice sets that do not exist or cannot be read, are
+// not validated.
+func (s *providerState) validateCustomFieldChoices(field *models.CustomField, values []string) error {
+	if field.ChoiceSet == nil || field.ChoiceSet.ID == 0 {
+		return nil
+	}
+
+	choiceSet, err := s.getCustomFieldChoiceSet(field.ChoiceSet.ID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"choice_set_id": field.ChoiceSet.ID,
+		}).Debugf("Not validating custom field choices: %v", err)
+		return nil
+	}
+	if choiceSet == nil || (choiceSet.BaseChoices != nil && choiceSet.BaseChoices.Value != "") {
+		return nil
+	}
+
+	choices := make([]string, 0, len(choiceSet.ExtraChoices))
+	for _, choice := range choiceSet.ExtraChoices {
+		if len(choice) > 0 {
+			choices = append(choices, choice[0])
+		}
+	}
+	for _, value := range values {
+		if !slices.Contains(choices, value) {
+			return fmt.Errorf("%q is not a valid choice. Valid choices are: %s", value, strings.Join(choices, ", "))
+		}
+	}
+	return nil
+}
+
+// getCustomFieldChoiceSet returns the choice set with the given ID, or nil if
+// no such choice set exists.
+func (s *providerState) getCustomFieldChoiceSet(id int64) (*models.CustomFieldChoiceSet, error) {
+	c := &s.customFieldCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if choiceSet, ok := c.choiceSets[id]; ok {
+		return choiceSet, nil
+	}
+
+	if c.choiceSets == nil {
+		c.choiceSets = make(map[int64]*models.CustomFieldChoiceSet)
+	}
+
+	params := extras.NewExtrasCustomFieldChoiceSetsReadParams().WithID(id)
+	res, err := s.Extras.ExtrasCustomFieldChoiceSetsRead(params, nil)
+	if err != nil {
+		if errapi, ok := err.(*extras.ExtrasCustomFieldChoiceSetsReadDefault); ok && errapi.Code() == 404 {
+			c.choiceSets[id] = nil
+			return nil, nil
+		}
+		return nil, fmt.Errorf("API Error trying to retrieve custom field choice set %d from netbox: %w", id, err)
+	}
+
+	c.choiceSets[id] = res.GetPayload()
+	return res.GetPayload(), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,351 @@
+package netbox
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldValueToAPI(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		fieldType string
+		value     string
+		expected  interface{}
+		expectErr bool
+	}{
+		{
+			name:      "Text",
+			fieldType: models.CustomFieldTypeValueText,
+			value:     "foo",
+			expected:  "foo",
+		},
+		{
+			name:      "EmptyText",
+			fieldType: models.CustomFieldTypeValueText,
+			value:     "",
+			expected:  "",
+		},
+		{
+			name:      "Integer",
+			fieldType: models.CustomFieldTypeValueInteger,
+			value:     "42",
+			expected:  int64(42),
+		},
+		{
+			name:      "EmptyInteger",
+			fieldType: models.CustomFieldTypeValueInteger,
+			value:     "",
+			expected:  nil,
+		},
+		{
+			name:      "InvalidInteger",
+			fieldType: models.CustomFieldTypeValueInteger,
+			value:     "foo",
+			expectErr: true,
+		},
+		{
+			name:      "Decimal",
+			fieldType: models.CustomFieldTypeValueDecimal,
+			value:     "1.5",
+			expected:  1.5,
+		},
+		{
+			name:      "Boolean",
+			fieldType: models.CustomFieldTypeValueBoolean,
+			value:     "true",
+			expected:  true,
+		},
+		{
+			name:      "InvalidBoolean",
+			fieldType: models.CustomFieldTypeValueBoolean,
+			value:     "yes please",
+			expectErr: true,
+		},
+		{
+			name:      "JSON",
+			fieldType: models.CustomFieldTypeValueJSON,
+			value:     `{"foo": [1, 2]}`,
+			expected:  map[string]interface{}{"foo": []interface{}{float64(1), float64(2)}},
+		},
+		{
+			name:      "JSONPlainString",
+			fieldType: models.CustomFieldTypeValueJSON,
+			value:     "foo",
+			expected:  "foo",
+		},
+		{
+			name:      "Multiselect",
+			fieldType: models.CustomFieldTypeValueMultiselect,
+			value:     `["a", "b"]`,
+			expected:  []string{"a", "b"},
+		},
+		{
+			name:      "MultiselectCommaSeparated",
+			fieldType: models.CustomFieldTypeValueMultiselect,
+			value:     "a, b",
+			expected:  []string{"a", "b"},
+		},
+		{
+			name:      "Object",
+			fieldType: models.CustomFieldTypeValueObject,
+			value:     "7",
+			expected:  int64(7),
+		},
+		{
+			name:      "Multiobject",
+			fieldType: models.CustomFieldTypeValueMultiobject,
+			value:     "[1, 2]",
+			expected:  []int64{1, 2},
+		},
+		{
+			name:      "InvalidMultiobject",
+			fieldType: models.CustomFieldTypeValueMultiobject,
+			value:     "a,b",
+			expectErr: true,
+		},
+		{
+			name:      "UnknownType",
+			fieldType: "",
+			value:     "foo",
+			expected:  "foo",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := customFieldValueToAPI(tt.fieldType, tt.value)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestCustomFieldValueFromAPI(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		fieldType string
+		value     interface{}
+		expected  interface{}
+	}{
+		{
+			name:      "Nil",
+			fieldType: models.CustomFieldTypeValueInteger,
+			value:     nil,
+			expected:  nil,
+		},
+		{
+			name:      "Text",
+			fieldType: models.CustomFieldTypeValueText,
+			value:     "foo",
+			expected:  "foo",
+		},
+		{
+			name:      "Integer",
+			fieldType: models.CustomFieldTypeValueInteger,
+			value:     float64(42),
+			expected:  "42",
+		},
+		{
+			name:      "IntegerJSONNumber",
+			fieldType: models.CustomFieldTypeValueInteger,
+			value:     json.Number("42"),
+			expected:  "42",
+		},
+		{
+			name:      "Decimal",
+			fieldType: models.CustomFieldTypeValueDecimal,
+			value:     1.5,
+			expected:  "1.5",
+		},
+		{
+			name:      "Boolean",
+			fieldType: models.CustomFieldTypeValueBoolean,
+			value:     false,
+			expected:  "false",
+		},
+		{
+			name:      "JSON",
+			fieldType: models.CustomFieldTypeValueJSON,
+			value:     map[string]interface{}{"foo": "bar"},
+			expected:  `{"foo":"bar"}`,
+		},
+		{
+			name:      "Multiselect",
+			fieldType: models.CustomFieldTypeValueMultiselect,
+			value:     []interface{}{"a", "b"},
+			expected:  `["a","b"]`,
+		},
+		{
+			name:      "Object",
+			fieldType: models.CustomFieldTypeValueObject,
+			value:     map[string]interface{}{"id": float64(7), "display": "foo"},
+			expected:  "7",
+		},
+		{
+			name:      "Multiobject",
+			fieldType: models.CustomFieldTypeValueMultiobject,
+			value: []interface{}{
+				map[string]interface{}{"id": float64(1)},
+				map[string]interface{}{"id": float64(2)},
+			},
+			expected: "[1,2]",
+		},
+		{
+			name:      "UnknownTypeNumber",
+			fieldType: "",
+			value:     float64(3),
+			expected:  "3",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, customFieldValueFromAPI(tt.fieldType, tt.value))
+		})
+	}
+}
+
+func TestCustomFieldValuesEqual(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		fieldType string
+		old       string
+		new       string
+		expected  bool
+	}{
+		{name: "TextEqual", fieldType: "text", old: "foo", new: "foo", expected: true},
+		{name: "TextDifferent", fieldType: "text", old: "foo", new: "bar", expected: false},
+		{name: "TextNumbers", fieldType: "text", old: "1.5", new: "1.50", expected: false},
+		{name: "TextBooleans", fieldType: "text", old: "true", new: "True", expected: false},
+		{name: "IntegerEqual", fieldType: "integer", old: "42", new: "42", expected: true},
+		{name: "IntegerAsDecimal", fieldType: "integer", old: "1", new: "1.0", expected: true},
+		{name: "IntegerDifferent", fieldType: "integer", old: "1", new: "2", expected: false},
+		{name: "IntegerAndText", fieldType: "integer", old: "1", new: "one", expected: false},
+		{name: "DecimalFormatting", fieldType: "decimal", old: "1.5", new: "1.50", expected: true},
+		{name: "DecimalWhitespace", fieldType: "decimal", old: "1.5", new: " 1.5 ", expected: true},
+		{name: "DecimalDifferent", fieldType: "decimal", old: "1.5", new: "1.05", expected: false},
+		{name: "DecimalEmpty", fieldType: "decimal", old: "", new: "0", expected: false},
+		{name: "BooleanCapitalized", fieldType: "boolean", old: "true", new: "True", expected: true},
+		{name: "BooleanNumeric", fieldType: "boolean", old: "true", new: "1", expected: true},
+		{name: "BooleanFalse", fieldType: "boolean", old: "false", new: "0", expected: true},
+		{name: "BooleanDifferent", fieldType: "boolean", old: "true", new: "false", expected: false},
+		{name: "BooleanInvalid", fieldType: "boolean", old: "true", new: "yes", expected: false},
+		{name: "MultiselectOrder", fieldType: "multiselect", old: `["a","b"]`, new: `["b", "a"]`, expected: true},
+		{name: "MultiselectCommaSeparated", fieldType: "multiselect", old: `["a","b"]`, new: "b, a", expected: true},
+		{name: "MultiselectDifferent", fieldType: "multiselect", old: `["a","b"]`, new: `["a","c"]`, expected: false},
+		{name: "MultiselectSubset", fieldType: "multiselect", old: `["a","b"]`, new: `["a"]`, expected: false},
+		{name: "MultiobjectFormatting", fieldType: "multiobject", old: `[1,2]`, new: `[1, 2]`, expected: true},
+		{name: "MultiobjectOrder", fieldType: "multiobject", old: `[1,2]`, new: `[2,1]`, expected: false},
+		{name: "JSONObjectFormatting", fieldType: "json", old: `{"a":1,"b":2}`, new: `{"b": 2, "a": 1}`, expected: true},
+		{name: "JSONDifferent", fieldType: "json", old: `{"a":1}`, new: `{"a":2}`, expected: false},
+		{name: "UnknownNumbers", old: "1.5", new: "1.50", expected: false},
+		{name: "UnknownJSONFormatting", old: `[1,2]`, new: `[1, 2]`, expected: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, customFieldValuesEqual(tt.fieldType, tt.old, tt.new))
+		})
+	}
+}
+
+func TestCustomFieldValueDiffSuppress(t *testing.T) {
+	customFieldTypes.Store("test_diff_suppress_decimal", "decimal")
+	defer customFieldTypes.Delete("test_diff_suppress_decimal")
+
+	assert.True(t, customFieldValueDiffSuppress("custom_fields.test_diff_suppress_decimal", "1.5", "1.50", nil))
+	assert.False(t, customFieldValueDiffSuppress("custom_fields.test_diff_suppress_unknown", "1.5", "1.50", nil))
+}
+
+func TestValidateCustomFieldValue(t *testing.T) {
+	state := &providerState{}
+	for _, tt := range []struct {
+		name      string
+		field     *models.CustomField
+		value     string
+		expectErr bool
+	}{
+		{
+			name:  "ValidInteger",
+			field: &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("integer")}, ObjectTypes: []string{"dcim.site"}, ValidationMinimum: int64ToPtr(1), ValidationMaximum: int64ToPtr(10)},
+			value: "5",
+		},
+		{
+			name:      "IntegerBelowMinimum",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("integer")}, ObjectTypes: []string{"dcim.site"}, ValidationMinimum: int64ToPtr(1)},
+			value:     "0",
+			expectErr: true,
+		},
+		{
+			name:      "IntegerAboveMaximum",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("integer")}, ObjectTypes: []string{"dcim.site"}, ValidationMaximum: int64ToPtr(10)},
+			value:     "11",
+			expectErr: true,
+		},
+		{
+			name:      "WrongType",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("boolean")}, ObjectTypes: []string{"dcim.site"}},
+			value:     "maybe",
+			expectErr: true,
+		},
+		{
+			name:      "WrongObjectType",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("text")}, ObjectTypes: []string{"dcim.device"}},
+			value:     "foo",
+			expectErr: true,
+		},
+		{
+			name:  "MatchingRegex",
+			field: &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("text")}, ObjectTypes: []string{"dcim.site"}, ValidationRegex: "^[A-Z]+-[0-9]+$"},
+			value: "TICKET-42",
+		},
+		{
+			name:      "NotMatchingRegex",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("text")}, ObjectTypes: []string{"dcim.site"}, ValidationRegex: "^[A-Z]+-[0-9]+$"},
+			value:     "ticket 42",
+			expectErr: true,
+		},
+		{
+			name:  "ValidDate",
+			field: &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("date")}, ObjectTypes: []string{"dcim.site"}},
+			value: "2024-02-29",
+		},
+		{
+			name:      "InvalidDate",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("date")}, ObjectTypes: []string{"dcim.site"}},
+			value:     "29.02.2024",
+			expectErr: true,
+		},
+		{
+			name:  "ValidChoice",
+			field: &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("multiselect")}, ObjectTypes: []string{"dcim.site"}, ChoiceSet: &models.CustomFieldChoiceSet{ID: 1}},
+			value: `["a", "b"]`,
+		},
+		{
+			name:      "InvalidChoice",
+			field:     &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("select")}, ObjectTypes: []string{"dcim.site"}, ChoiceSet: &models.CustomFieldChoiceSet{ID: 1}},
+			value:     "c",
+			expectErr: true,
+		},
+		{
+			name:  "MissingChoiceSet",
+			field: &models.CustomField{Type: &models.CustomFieldType{Value: strToPtr("select")}, ObjectTypes: []string{"dcim.site"}, ChoiceSet: &models.CustomFieldChoiceSet{ID: 2}},
+			value: "c",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			state.customFieldCache.choiceSets = map[int64]*models.CustomFieldChoiceSet{
+				1: {ExtraChoices: [][]string{{"a", "A"}, {"b", "B"}}},
+				2: nil,
+			}
+
+			err := state.validateCustomFieldValue(tt.field, "dcim.site", tt.value)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +37,7 @@ func dataSourceNetboxAsns() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
 			},
+			pageSizeKey: pageSizeSchema,
 			"asns": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -67,42 +68,29 @@ func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
 
 	params := ipam.NewIpamAsnsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "asn":
-				params.Asn = &vString
-			case "asn__gte":
-				params.AsnGte = &vString
-			case "asn__lte":
-				params.AsnLte = &vString
-			case "asn__n":
-				params.Asnn = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredAsns, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.ASN, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamAsnsList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Ipam.IpamAsnsList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredAsns) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredAsns := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredAsns {
 		var mapping = make(map[string]interface{})
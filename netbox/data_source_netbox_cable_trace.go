@@ -0,0 +1,229 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cableTraceEndpoints maps the object types that can be traced to their API
+// endpoints.
+var cableTraceEndpoints = map[string]string{
+	"dcim.interface":         "dcim/interfaces",
+	"dcim.frontport":         "dcim/front-ports",
+	"dcim.rearport":          "dcim/rear-ports",
+	"dcim.consoleport":       "dcim/console-ports",
+	"dcim.consoleserverport": "dcim/console-server-ports",
+	"dcim.powerport":         "dcim/power-ports",
+	"dcim.poweroutlet":       "dcim/power-outlets",
+	"dcim.powerfeed":         "dcim/power-feeds",
+}
+
+// cablePassThroughTypes are the object types a cable path passes through.
+// A path ending on one of them is not complete.
+var cablePassThroughTypes = map[string]bool{
+	"dcim.frontport": true,
+	"dcim.rearport":  true,
+}
+
+var cableTraceObjectSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"object_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"object_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	},
+}
+
+func dataSourceNetboxCableTrace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxCableTraceRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Traces the cable path starting at a device component, e.g. to find out what an interface is connected to through patch panels.`,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sortedKeys(cableTraceEndpoints), false),
+				Description:  "The type of the object to start the trace at. " + buildValidValueDescription(sortedKeys(cableTraceEndpoints)),
+			},
+			"object_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the object to start the trace at.",
+			},
+			"hops": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The segments of the cable path, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"near_end": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     cableTraceObjectSchema,
+						},
+						"cable_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the cable connecting the near and the far end. Not set if the segment is not cabled.",
+						},
+						"far_end": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     cableTraceObjectSchema,
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The objects the path ends at.",
+				Elem:        cableTraceObjectSchema,
+			},
+			"is_complete": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the path ends at a path endpoint like an interface rather than an unconnected front or rear port.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxCableTraceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	objectType := d.Get("object_type").(string)
+	objectID := d.Get("object_id").(int)
+
+	var trace []json.RawMessage
+	if err := api.getRaw(ctx, fmt.Sprintf("%s/%d/trace", cableTraceEndpoints[objectType], objectID), nil, &trace); err != nil {
+		return diag.FromErr(err)
+	}
+
+	hops, endpoint, err := parseCableTrace(trace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isComplete := len(endpoint) > 0
+	for _, e := range endpoint {
+		if cablePassThroughTypes[e["object_type"].(string)] {
+			isComplete = false
+		}
+	}
+
+	d.SetId(id.UniqueId())
+	if err := d.Set("hops", hops); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("endpoint", endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("is_complete", isComplete))
+}
+
+// parseCableTrace converts the response of a trace endpoint into hops and
+// the far end of the last hop. Each hop is returned by the API as a list of
+// the near end, the cable and the far end.
+func parseCableTrace(trace []json.RawMessage) ([]map[string]interface{}, []map[string]interface{}, error) {
+	hops := make([]map[string]interface{}, 0, len(trace))
+	var endpoint []map[string]interface{}
+
+	for _, rawHop := range trace {
+		var hop []json.RawMessage
+		if err := json.Unmarshal(rawHop, &hop); err != nil {
+			return nil, nil, err
+		}
+		if len(hop) != 3 {
+			return nil, nil, fmt.Errorf("unexpected cable trace segment %s", string(rawHop))
+		}
+
+		nearEnd, err := parseCableTraceObjects(hop[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		farEnd, err := parseCableTraceObjects(hop[2])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		mapping := map[string]interface{}{
+			"near_end": nearEnd,
+			"far_end":  farEnd,
+		}
+		var cable *struct {
+			ID int64 `json:"id"`
+		}
+		if err := json.Unmarshal(hop[1], &cable); err != nil {
+			return nil, nil, err
+		}
+		if cable != nil {
+			mapping["cable_id"] = cable.ID
+		}
+
+		hops = append(hops, mapping)
+		endpoint = farEnd
+	}
+
+	return hops, endpoint, nil
+}
+
+// parseCableTraceObjects parses one end of a trace segment, which is a list
+// of objects, a single object or null.
+func parseCableTraceObjects(raw json.RawMessage) ([]map[string]interface{}, error) {
+	type traceObject struct {
+		ID  int64  `json:"id"`
+		URL string `json:"url"`
+	}
+
+	var objects []traceObject
+	if err := json.Unmarshal(raw, &objects); err != nil {
+		var object *traceObject
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return nil, err
+		}
+		if object != nil {
+			objects = append(objects, *object)
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(objects))
+	for _, o := range objects {
+		result = append(result, map[string]interface{}{
+			"object_type": getObjectTypeFromURL(o.URL),
+			"object_id":   o.ID,
+		})
+	}
+	return result, nil
+}
+
+// getObjectTypeFromURL derives the object type, e.g. `dcim.frontport`, from
+// the API URL of an object, e.g. `https://netbox/api/dcim/front-ports/1/`.
+func getObjectTypeFromURL(objectURL string) string {
+	path := objectURL
+	if u, err := url.Parse(objectURL); err == nil {
+		path = u.Path
+	}
+	if i := strings.Index(path, "/api/"); i >= 0 {
+		path = path[i+len("/api/"):]
+	}
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	model := strings.ReplaceAll(strings.TrimSuffix(parts[1], "s"), "-", "")
+	return parts[0] + "." + model
+}
@@ -0,0 +1,193 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectTypeFromURL(t *testing.T) {
+	for input, expected := range map[string]string{
+		"https://netbox.example.com/api/dcim/interfaces/1/":               "dcim.interface",
+		"http://localhost:8001/api/dcim/front-ports/2/":                   "dcim.frontport",
+		"/api/dcim/console-server-ports/3/":                               "dcim.consoleserverport",
+		"https://netbox.example.com/api/circuits/circuit-terminations/4/": "circuits.circuittermination",
+		"": "",
+	} {
+		assert.Equal(t, expected, getObjectTypeFromURL(input), input)
+	}
+}
+
+func TestParseCableTrace(t *testing.T) {
+	var trace []json.RawMessage
+	err := json.Unmarshal([]byte(`[
+  [
+    [{"id": 1, "url": "http://netbox/api/dcim/interfaces/1/", "name": "eth0"}],
+    {"id": 10, "url": "http://netbox/api/dcim/cables/10/"},
+    [{"id": 2, "url": "http://netbox/api/dcim/front-ports/2/"}]
+  ],
+  [
+    [{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/"}],
+    {"id": 11, "url": "http://netbox/api/dcim/cables/11/"},
+    [{"id": 4, "url": "http://netbox/api/dcim/interfaces/4/"}, {"id": 5, "url": "http://netbox/api/dcim/interfaces/5/"}]
+  ],
+  [
+    [{"id": 6, "url": "http://netbox/api/dcim/front-ports/6/"}],
+    null,
+    null
+  ]
+]`), &trace)
+	assert.NoError(t, err)
+
+	hops, endpoint, err := parseCableTrace(trace)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"near_end": []map[string]interface{}{{"object_type": "dcim.interface", "object_id": int64(1)}},
+			"cable_id": int64(10),
+			"far_end":  []map[string]interface{}{{"object_type": "dcim.frontport", "object_id": int64(2)}},
+		},
+		{
+			"near_end": []map[string]interface{}{{"object_type": "dcim.rearport", "object_id": int64(3)}},
+			"cable_id": int64(11),
+			"far_end": []map[string]interface{}{
+				{"object_type": "dcim.interface", "object_id": int64(4)},
+				{"object_type": "dcim.interface", "object_id": int64(5)},
+			},
+		},
+		{
+			"near_end": []map[string]interface{}{{"object_type": "dcim.frontport", "object_id": int64(6)}},
+			"far_end":  []map[string]interface{}{},
+		},
+	}, hops)
+	assert.Empty(t, endpoint)
+
+	_, _, err = parseCableTrace([]json.RawMessage{json.RawMessage(`[[], null]`)})
+	assert.Error(t, err)
+}
+
+func TestAccNetboxCableTraceDataSource_basic(t *testing.T) {
+	testSlug := "cable_trace_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "a" {
+  name = "%[1]s_a"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device" "patch_panel" {
+  name = "%[1]s_pp"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device" "b" {
+  name = "%[1]s_b"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "a" {
+  name = "eth0"
+  device_id = netbox_device.a.id
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "b" {
+  name = "eth0"
+  device_id = netbox_device.b.id
+  type = "1000base-t"
+}
+
+resource "netbox_device_rear_port" "test" {
+  device_id = netbox_device.patch_panel.id
+  name = "rear1"
+  type = "8p8c"
+  positions = 1
+}
+
+resource "netbox_device_front_port" "test" {
+  device_id = netbox_device.patch_panel.id
+  name = "front1"
+  type = "8p8c"
+  rear_port_id = netbox_device_rear_port.test.id
+  rear_port_position = 1
+}
+
+resource "netbox_cable" "a" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.a.id
+  }
+  b_termination {
+    object_type = "dcim.frontport"
+    object_id = netbox_device_front_port.test.id
+  }
+  status = "connected"
+}
+
+resource "netbox_cable" "b" {
+  a_termination {
+    object_type = "dcim.rearport"
+    object_id = netbox_device_rear_port.test.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.b.id
+  }
+  status = "connected"
+}
+
+data "netbox_cable_trace" "test" {
+  depends_on = [netbox_cable.a, netbox_cable.b]
+  object_type = "dcim.interface"
+  object_id = netbox_device_interface.a.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "hops.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "hops.0.near_end.0.object_id", "netbox_device_interface.a", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "hops.0.cable_id", "netbox_cable.a", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "hops.0.far_end.0.object_type", "dcim.frontport"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "hops.0.far_end.0.object_id", "netbox_device_front_port.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "hops.1.near_end.0.object_type", "dcim.rearport"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "hops.1.cable_id", "netbox_cable.b", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "endpoint.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "endpoint.0.object_type", "dcim.interface"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "endpoint.0.object_id", "netbox_device_interface.b", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "is_complete", "true"),
+				),
+			},
+		},
+	})
+}
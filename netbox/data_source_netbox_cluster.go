@@ -148,7 +148,7 @@ func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if result.CustomFields != nil {
-		d.Set("custom_fields", result.CustomFields)
+		d.Set("custom_fields", api.getCustomFields(result.CustomFields))
 	}
 
 	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
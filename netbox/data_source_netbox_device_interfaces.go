@@ -2,7 +2,6 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
@@ -42,6 +41,7 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			pageSizeKey: pageSizeSchema,
 			"interfaces": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -168,51 +168,39 @@ func dataSourceNetboxDeviceInterfaceRead(d *schema.ResourceData, m interface{})
 
 	params := dcim.NewDcimInterfacesListParams()
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		filterParams := filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "mac_address":
-				params.MacAddress = &vString
-			case "name":
-				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} // TODO: switch schema to list?
-			case "device_id":
-				params.DeviceID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	interfaces, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.Interface, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Dcim.DcimInterfacesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Dcim.DcimInterfacesList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(interfaces) == 0 {
 		return errors.New("no result")
 	}
 
 	var filteredInterfaces []*models.Interface
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, dcimInterface := range res.GetPayload().Results {
+		for _, dcimInterface := range interfaces {
 			if r.MatchString(*dcimInterface.Name) {
 				filteredInterfaces = append(filteredInterfaces, dcimInterface)
 			}
 		}
 	} else {
-		filteredInterfaces = res.GetPayload().Results
+		filteredInterfaces = interfaces
 	}
 
 	var s []map[string]interface{}
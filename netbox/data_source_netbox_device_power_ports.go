@@ -42,6 +42,7 @@ func dataSourceNetboxDevicePowerPorts() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			pageSizeKey: pageSizeSchema,
 			"power_ports": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -102,36 +103,26 @@ func dataSourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{})
 
 	params := dcim.NewDcimPowerPortsListParams()
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
-			case "device_id":
-				params.DeviceID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	powerPorts, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.PowerPort, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Dcim.DcimPowerPortsList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Dcim.DcimPowerPortsList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(powerPorts) == 0 {
 		return errors.New("no result")
 	}
 
@@ -141,13 +132,13 @@ func dataSourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{})
 		if err != nil {
 			return fmt.Errorf("failed to compile name regex: %w", err)
 		}
-		for _, port := range res.GetPayload().Results {
+		for _, port := range powerPorts {
 			if r.MatchString(*port.Name) {
 				filteredInterfaces = append(filteredInterfaces, port)
 			}
 		}
 	} else {
-		filteredInterfaces = res.GetPayload().Results
+		filteredInterfaces = powerPorts
 	}
 
 	var s []map[string]interface{}
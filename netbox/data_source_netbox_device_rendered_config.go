@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// renderedConfig is the response of the render-config endpoints of devices
+// and virtual machines.
+type renderedConfig struct {
+	ConfigTemplate struct {
+		ID int64 `json:"id"`
+	} `json:"configtemplate"`
+	Content string `json:"content"`
+}
+
+func dataSourceNetboxDeviceRenderedConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxDeviceRenderedConfigRead,
+		Description: `:meta:subcategory:Extras:Renders the configuration of a device using its config template. The template is taken from the device, its role or its platform, in that order.`,
+		Schema:      renderedConfigSchema("device_id", "The ID of the device to render the configuration for."),
+	}
+}
+
+// renderedConfigSchema returns the schema shared by the rendered config data
+// sources, with objectKey being the attribute holding the object ID.
+func renderedConfigSchema(objectKey string, objectDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		objectKey: {
+			Type:        schema.TypeInt,
+			Required:    true,
+			Description: objectDescription,
+		},
+		"context": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			Description:  "Additional context passed to the template as a JSON object, e.g. via `jsonencode()`. It is merged with the config context of the object.",
+		},
+		"config_template_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the config template used for rendering.",
+		},
+		"content": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The rendered configuration.",
+		},
+	}
+}
+
+func dataSourceNetboxDeviceRenderedConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return readRenderedConfig(ctx, d, m.(*providerState), "dcim/devices", d.Get("device_id").(int))
+}
+
+func readRenderedConfig(ctx context.Context, d *schema.ResourceData, api *providerState, path string, objectID int) diag.Diagnostics {
+	extraContext := map[string]interface{}{}
+	if v, ok := d.GetOk("context"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &extraContext); err != nil {
+			return diag.Errorf("context must be a JSON object: %s", err)
+		}
+	}
+
+	var rendered renderedConfig
+	if err := api.postRaw(ctx, fmt.Sprintf("%s/%d/render-config", path, objectID), extraContext, &rendered); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(objectID))
+	d.Set("config_template_id", rendered.ConfigTemplate.ID)
+	d.Set("content", rendered.Content)
+	return nil
+}
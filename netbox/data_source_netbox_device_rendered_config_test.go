@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceRenderedConfigDataSource_basic(t *testing.T) {
+	testSlug := "device_rendered_config"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_config_template" "test" {
+  name = "%[1]s"
+  template_code = "hostname {{ device.name }}.{{ domain }}"
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  role_id = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  site_id = netbox_site.test.id
+  config_template_id = netbox_config_template.test.id
+}
+
+data "netbox_device_rendered_config" "test" {
+  device_id = netbox_device.test.id
+  context = jsonencode({ domain = "example.com" })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_device_rendered_config.test", "config_template_id", "netbox_config_template.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_rendered_config.test", "content", fmt.Sprintf("hostname %s.example.com", testName)),
+				),
+			},
+		},
+	})
+}
@@ -5,10 +5,8 @@ package netbox
 
 import (
 	"encoding/json"
-	"fmt"
 	"net"
 	"regexp"
-	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -47,6 +45,7 @@ func dataSourceNetboxDevices() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			pageSizeKey: pageSizeSchema,
 			"devices": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -165,60 +164,23 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 
 	params := dcim.NewDcimDevicesListParams()
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			switch k {
-			case "asset_tag":
-				var assetTagString = v.(string)
-				params.AssetTag = &assetTagString
-			case "cluster_id":
-				var clusterString = v.(string)
-				params.ClusterID = &clusterString
-			case "device_type_id":
-				var deviceTypeIDString = v.(string)
-				params.DeviceTypeID = &deviceTypeIDString
-			case "name":
-				var nameString = v.(string)
-				params.Name = &nameString
-			case "region":
-				var regionString = v.(string)
-				params.Region = &regionString
-			case "role_id":
-				var roleIDString = v.(string)
-				params.RoleID = &roleIDString
-			case "site_id":
-				var siteIDString = v.(string)
-				params.SiteID = &siteIDString
-			case "location_id":
-				var locationIDString = v.(string)
-				params.LocationID = &locationIDString
-			case "rack_id":
-				var rackIDString = v.(string)
-				params.RackID = &rackIDString
-			case "tenant_id":
-				var tenantIDString = v.(string)
-				params.TenantID = &tenantIDString
-			case "tags":
-				var tagsString = v.(string)
-				params.Tag = strings.Split(tagsString, ",")
-			case "status":
-				var statusString = v.(string)
-				params.Status = &statusString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
-	}
-
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	query, err := getFilterQuery(d, params, map[string]filterAlias{
+		"tags": {name: "tag", separator: ","},
+	})
+	if err != nil {
+		return err
 	}
 
-	res, err := api.Dcim.DcimDevicesList(params, nil)
+	limit, pageSize := getListLimits(d)
+	devices, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.DeviceWithConfigContext, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Dcim.DcimDevicesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
@@ -226,13 +188,13 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 	var filteredDevices []*models.DeviceWithConfigContext
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, device := range res.GetPayload().Results {
+		for _, device := range devices {
 			if r.MatchString(*device.Name) {
 				filteredDevices = append(filteredDevices, device)
 			}
 		}
 	} else {
-		filteredDevices = res.GetPayload().Results
+		filteredDevices = devices
 	}
 
 	var s []map[string]interface{}
@@ -295,7 +257,7 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 			mapping["status"] = *device.Status.Value
 		}
 		if device.CustomFields != nil {
-			mapping["custom_fields"] = device.CustomFields
+			mapping["custom_fields"] = api.getCustomFields(device.CustomFields)
 		}
 		if device.Rack != nil {
 			mapping["rack_id"] = device.Rack.ID
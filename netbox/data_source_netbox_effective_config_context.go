@@ -0,0 +1,338 @@
+package netbox
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
+	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxEffectiveConfigContext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxEffectiveConfigContextRead,
+		Description: `:meta:subcategory:Extras:Returns the config context of a device or virtual machine as rendered by Netbox, together with the config contexts it is merged from.
+
+The contributing config contexts are determined the same way Netbox does: a context applies if it is active and, for each kind of assignment, it is either not assigned at all or assigned to a matching object. Regions, site groups and locations also match their descendants.`,
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "virtual_machine_id"},
+			},
+			"virtual_machine_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "virtual_machine_id"},
+			},
+			"config_context": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The merged config context as JSON, including the local context data.",
+			},
+			"local_context_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The local context data of the object as JSON.",
+			},
+			"config_context_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the config contexts merged into the config context, in the order they are applied. Later contexts take precedence over earlier ones.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+// configContextTarget holds the assignments of a device or virtual machine
+// that are relevant for matching config contexts. Unset IDs are 0.
+type configContextTarget struct {
+	regions      []int64
+	siteGroups   []int64
+	site         int64
+	locations    []int64
+	deviceType   int64
+	role         int64
+	platform     int64
+	clusterType  int64
+	clusterGroup int64
+	cluster      int64
+	tenantGroup  int64
+	tenant       int64
+	tags         []string
+}
+
+func dataSourceNetboxEffectiveConfigContextRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var objectID int64
+	var configContext, localContextData interface{}
+	var target configContextTarget
+	var site *models.NestedSite
+	var cluster *models.NestedCluster
+	var tenant *models.NestedTenant
+
+	if v, ok := d.GetOk("device_id"); ok {
+		objectID = int64(v.(int))
+		res, err := api.Dcim.DcimDevicesRead(dcim.NewDcimDevicesReadParams().WithID(objectID), nil)
+		if err != nil {
+			return err
+		}
+		device := res.GetPayload()
+
+		configContext, localContextData = device.ConfigContext, device.LocalContextData
+		site, cluster, tenant = device.Site, device.Cluster, device.Tenant
+		if device.DeviceType != nil {
+			target.deviceType = device.DeviceType.ID
+		}
+		if device.Role != nil {
+			target.role = device.Role.ID
+		}
+		if device.Platform != nil {
+			target.platform = device.Platform.ID
+		}
+		target.tags = getTagSlugs(device.Tags)
+		if device.Location != nil {
+			target.locations, err = getAncestorIDs(device.Location.ID, func(id int64) (*models.NestedLocation, error) {
+				res, err := api.Dcim.DcimLocationsRead(dcim.NewDcimLocationsReadParams().WithID(id), nil)
+				if err != nil {
+					return nil, err
+				}
+				return res.GetPayload().Parent, nil
+			}, func(l *models.NestedLocation) int64 { return l.ID })
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		objectID = int64(d.Get("virtual_machine_id").(int))
+		res, err := api.Virtualization.VirtualizationVirtualMachinesRead(virtualization.NewVirtualizationVirtualMachinesReadParams().WithID(objectID), nil)
+		if err != nil {
+			return err
+		}
+		vm := res.GetPayload()
+
+		configContext, localContextData = vm.ConfigContext, vm.LocalContextData
+		site, cluster, tenant = vm.Site, vm.Cluster, vm.Tenant
+		if vm.Role != nil {
+			target.role = vm.Role.ID
+		}
+		if vm.Platform != nil {
+			target.platform = vm.Platform.ID
+		}
+		target.tags = getTagSlugs(vm.Tags)
+	}
+
+	if site != nil {
+		target.site = site.ID
+		res, err := api.Dcim.DcimSitesRead(dcim.NewDcimSitesReadParams().WithID(site.ID), nil)
+		if err != nil {
+			return err
+		}
+		if region := res.GetPayload().Region; region != nil {
+			target.regions, err = getAncestorIDs(region.ID, func(id int64) (*models.NestedRegion, error) {
+				res, err := api.Dcim.DcimRegionsRead(dcim.NewDcimRegionsReadParams().WithID(id), nil)
+				if err != nil {
+					return nil, err
+				}
+				return res.GetPayload().Parent, nil
+			}, func(r *models.NestedRegion) int64 { return r.ID })
+			if err != nil {
+				return err
+			}
+		}
+		if group := res.GetPayload().Group; group != nil {
+			target.siteGroups, err = getAncestorIDs(group.ID, func(id int64) (*models.NestedSiteGroup, error) {
+				res, err := api.Dcim.DcimSiteGroupsRead(dcim.NewDcimSiteGroupsReadParams().WithID(id), nil)
+				if err != nil {
+					return nil, err
+				}
+				return res.GetPayload().Parent, nil
+			}, func(g *models.NestedSiteGroup) int64 { return g.ID })
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if cluster != nil {
+		target.cluster = cluster.ID
+		res, err := api.Virtualization.VirtualizationClustersRead(virtualization.NewVirtualizationClustersReadParams().WithID(cluster.ID), nil)
+		if err != nil {
+			return err
+		}
+		if res.GetPayload().Type != nil {
+			target.clusterType = res.GetPayload().Type.ID
+		}
+		if res.GetPayload().Group != nil {
+			target.clusterGroup = res.GetPayload().Group.ID
+		}
+	}
+
+	if tenant != nil {
+		target.tenant = tenant.ID
+		res, err := api.Tenancy.TenancyTenantsRead(tenancy.NewTenancyTenantsReadParams().WithID(tenant.ID), nil)
+		if err != nil {
+			return err
+		}
+		if res.GetPayload().Group != nil {
+			target.tenantGroup = res.GetPayload().Group.ID
+		}
+	}
+
+	params := extras.NewExtrasConfigContextsListParams()
+	isActive := "true"
+	params.IsActive = &isActive
+	configContexts, err := listAll(0, 0, func(limit, offset *int64) ([]*models.ConfigContext, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Extras.ExtrasConfigContextsList(params, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(objectID, 10))
+
+	configContextJSON, err := json.Marshal(configContext)
+	if err != nil {
+		return err
+	}
+	d.Set("config_context", string(configContextJSON))
+
+	if localContextData != nil {
+		localContextDataJSON, err := json.Marshal(localContextData)
+		if err != nil {
+			return err
+		}
+		d.Set("local_context_data", string(localContextDataJSON))
+	} else {
+		d.Set("local_context_data", nil)
+	}
+
+	return d.Set("config_context_ids", getMatchingConfigContextIDs(configContexts, target))
+}
+
+// getMatchingConfigContextIDs returns the IDs of the config contexts that
+// apply to the target, ordered by weight and name like Netbox merges them.
+func getMatchingConfigContextIDs(configContexts []*models.ConfigContext, target configContextTarget) []int64 {
+	var matching []*models.ConfigContext
+	for _, c := range configContexts {
+		if target.matches(c) {
+			matching = append(matching, c)
+		}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		wi, wj := int64(0), int64(0)
+		if matching[i].Weight != nil {
+			wi = *matching[i].Weight
+		}
+		if matching[j].Weight != nil {
+			wj = *matching[j].Weight
+		}
+		if wi != wj {
+			return wi < wj
+		}
+		var ni, nj string
+		if matching[i].Name != nil {
+			ni = *matching[i].Name
+		}
+		if matching[j].Name != nil {
+			nj = *matching[j].Name
+		}
+		return ni < nj
+	})
+
+	ids := make([]int64, 0, len(matching))
+	for _, c := range matching {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func (t configContextTarget) matches(c *models.ConfigContext) bool {
+	return matchesAssignment(c.Regions, t.regions, func(r *models.NestedRegion) int64 { return r.ID }) &&
+		matchesAssignment(c.SiteGroups, t.siteGroups, func(g *models.NestedSiteGroup) int64 { return g.ID }) &&
+		matchesAssignment(c.Sites, []int64{t.site}, func(s *models.NestedSite) int64 { return s.ID }) &&
+		matchesAssignment(c.Locations, t.locations, func(l *models.NestedLocation) int64 { return l.ID }) &&
+		matchesAssignment(c.DeviceTypes, []int64{t.deviceType}, func(dt *models.NestedDeviceType) int64 { return dt.ID }) &&
+		matchesAssignment(c.Roles, []int64{t.role}, func(r *models.NestedDeviceRole) int64 { return r.ID }) &&
+		matchesAssignment(c.Platforms, []int64{t.platform}, func(p *models.NestedPlatform) int64 { return p.ID }) &&
+		matchesAssignment(c.ClusterTypes, []int64{t.clusterType}, func(ct *models.NestedClusterType) int64 { return ct.ID }) &&
+		matchesAssignment(c.ClusterGroups, []int64{t.clusterGroup}, func(cg *models.NestedClusterGroup) int64 { return cg.ID }) &&
+		matchesAssignment(c.Clusters, []int64{t.cluster}, func(cl *models.NestedCluster) int64 { return cl.ID }) &&
+		matchesAssignment(c.TenantGroups, []int64{t.tenantGroup}, func(tg *models.NestedTenantGroup) int64 { return tg.ID }) &&
+		matchesAssignment(c.Tenants, []int64{t.tenant}, func(tn *models.NestedTenant) int64 { return tn.ID }) &&
+		matchesTags(c.Tags, t.tags)
+}
+
+// matchesAssignment reports whether a config context with the given
+// assignments applies to an object with the given IDs. A context without
+// assignments applies to every object.
+func matchesAssignment[T any](assigned []*T, ids []int64, getID func(*T) int64) bool {
+	if len(assigned) == 0 {
+		return true
+	}
+	for _, a := range assigned {
+		for _, id := range ids {
+			if id != 0 && getID(a) == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesTags(assigned []string, tags []string) bool {
+	if len(assigned) == 0 {
+		return true
+	}
+	for _, a := range assigned {
+		for _, tag := range tags {
+			if a == tag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getAncestorIDs returns the given ID followed by the IDs of all ancestors of
+// the object, using getParent to look up the parent of an object.
+func getAncestorIDs[T any](id int64, getParent func(id int64) (*T, error), getID func(*T) int64) ([]int64, error) {
+	ids := []int64{id}
+	for {
+		parent, err := getParent(id)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return ids, nil
+		}
+		id = getID(parent)
+		ids = append(ids, id)
+	}
+}
+
+func getTagSlugs(tags []*models.NestedTag) []string {
+	slugs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if t.Slug != nil {
+			slugs = append(slugs, *t.Slug)
+		}
+	}
+	return slugs
+}
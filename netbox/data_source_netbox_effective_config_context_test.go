@@ -0,0 +1,144 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMatchingConfigContextIDs(t *testing.T) {
+	weight := func(w int64) *int64 { return &w }
+
+	configContexts := []*models.ConfigContext{
+		{ID: 1, Name: strToPtr("global"), Weight: weight(1000)},
+		{ID: 2, Name: strToPtr("site"), Weight: weight(2000), Sites: []*models.NestedSite{{ID: 10}}},
+		{ID: 3, Name: strToPtr("other site"), Weight: weight(2000), Sites: []*models.NestedSite{{ID: 11}}},
+		{ID: 4, Name: strToPtr("parent region"), Weight: weight(500), Regions: []*models.NestedRegion{{ID: 21}}},
+		{ID: 5, Name: strToPtr("a tag"), Weight: weight(2000), Tags: []string{"foo", "bar"}},
+		{ID: 6, Name: strToPtr("site and role"), Weight: weight(3000), Sites: []*models.NestedSite{{ID: 10}}, Roles: []*models.NestedDeviceRole{{ID: 31}}},
+		{ID: 7, Name: strToPtr("device type"), Weight: weight(3000), DeviceTypes: []*models.NestedDeviceType{{ID: 40}}},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		target   configContextTarget
+		expected []int64
+	}{
+		{
+			name:     "unassigned",
+			target:   configContextTarget{},
+			expected: []int64{1},
+		},
+		{
+			name:     "site and ancestor region",
+			target:   configContextTarget{site: 10, regions: []int64{20, 21}, role: 30},
+			expected: []int64{4, 1, 2},
+		},
+		{
+			name:     "ordered by weight and name",
+			target:   configContextTarget{site: 10, role: 31, tags: []string{"bar"}},
+			expected: []int64{1, 5, 2, 6},
+		},
+		{
+			name:     "virtual machine without device type",
+			target:   configContextTarget{site: 11},
+			expected: []int64{1, 3},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getMatchingConfigContextIDs(configContexts, tt.target))
+		})
+	}
+}
+
+func TestAccNetboxEffectiveConfigContextDataSource_basic(t *testing.T) {
+	testSlug := "eff_cfct_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_site" "other" {
+  name = "%[1]s_other"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_config_context" "site" {
+  name = "%[1]s_site"
+  weight = 1000
+  sites = [netbox_site.test.id]
+  data = jsonencode({ "%[1]s" = "site", "%[1]s_site" = true })
+}
+
+resource "netbox_config_context" "role" {
+  name = "%[1]s_role"
+  weight = 2000
+  roles = [netbox_device_role.test.id]
+  data = jsonencode({ "%[1]s" = "role" })
+}
+
+resource "netbox_config_context" "other" {
+  name = "%[1]s_other"
+  weight = 3000
+  sites = [netbox_site.other.id]
+  data = jsonencode({ "%[1]s" = "other" })
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  role_id = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  site_id = netbox_site.test.id
+  local_context_data = jsonencode({ "%[1]s_local" = true })
+}
+
+data "netbox_effective_config_context" "test" {
+  depends_on = [netbox_config_context.site, netbox_config_context.role, netbox_config_context.other]
+  device_id = netbox_device.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.netbox_effective_config_context.test", "config_context_ids.*", "netbox_config_context.site", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.netbox_effective_config_context.test", "config_context_ids.*", "netbox_config_context.role", "id"),
+					resource.TestCheckResourceAttrWith("data.netbox_effective_config_context.test", "config_context", func(value string) error {
+						for _, expected := range []string{
+							fmt.Sprintf(`"%s":"role"`, testName),
+							fmt.Sprintf(`"%s_site":true`, testName),
+							fmt.Sprintf(`"%s_local":true`, testName),
+						} {
+							if !strings.Contains(value, expected) {
+								return fmt.Errorf("expected %s in config context %s", expected, value)
+							}
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("data.netbox_effective_config_context.test", "local_context_data", fmt.Sprintf(`{"%s_local":true}`, testName)),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,6 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
@@ -40,6 +39,7 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 				Default:          0,
 				Description:      "The limit of objects to return from the API lookup.",
 			},
+			pageSizeKey: pageSizeSchema,
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -146,50 +146,41 @@ func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error
 
 	params := virtualization.NewVirtualizationInterfacesListParams()
 
-	params.Limit = getOptionalInt(d, "limit")
-
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "cluster_id":
-				params.ClusterID = &vString
-			case "mac_address":
-				params.MacAddress = &vString
-			case "name":
-				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
-			case "vm_id":
-				params.VirtualMachineID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
+	query, err := getFilterQuery(d, params, map[string]filterAlias{
+		"vm_id": {name: "virtual_machine_id"},
+	})
+	if err != nil {
+		return err
 	}
 
-	res, err := api.Virtualization.VirtualizationInterfacesList(params, nil)
+	limit, pageSize := getListLimits(d)
+	vmInterfaces, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.VMInterface, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Virtualization.VirtualizationInterfacesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(vmInterfaces) == 0 {
 		return errors.New("no result")
 	}
 
 	var filteredInterfaces []*models.VMInterface
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, vmInterface := range res.GetPayload().Results {
+		for _, vmInterface := range vmInterfaces {
 			if r.MatchString(*vmInterface.Name) {
 				filteredInterfaces = append(filteredInterfaces, vmInterface)
 			}
 		}
 	} else {
-		filteredInterfaces = res.GetPayload().Results
+		filteredInterfaces = vmInterfaces
 	}
 
 	var s []map[string]interface{}
@@ -124,7 +124,7 @@ func dataSourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error
 	d.Set("description", result.Description)
 	d.Set("created", result.Created.String())
 	d.Set("last_updated", result.LastUpdated.String())
-	d.Set("custom_fields", result.CustomFields)
+	d.Set("custom_fields", api.getCustomFields(result.CustomFields))
 	d.Set("address_family", result.Family.Label)
 	d.Set("status", result.Status.Value)
 	d.Set("dns_name", result.DNSName)
@@ -2,7 +2,6 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -36,8 +35,9 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-				Default:          1000,
+				Description:      "The maximum number of IP addresses to return. All matching IP addresses are returned if unset. Earlier versions of the provider defaulted to `1000`.",
 			},
+			pageSizeKey: pageSizeSchema,
 			"ip_addresses": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -140,58 +140,33 @@ func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) erro
 
 	params := ipam.NewIpamIPAddressesListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, map[string]filterAlias{
+		"ip_address":      {name: "address"},
+		"vm_interface_id": {name: "vminterface_id"},
+		"parent_prefix":   {name: "parent"},
+	})
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "dns_name":
-				params.DNSName = &vString
-			case "interface_id":
-				params.InterfaceID = &vString
-			case "device_id":
-				params.DeviceID = &vString
-			case "ip_address":
-				params.Address = &vString
-			case "vm_interface_id":
-				params.VminterfaceID = &vString
-			case "role":
-				params.Role = &vString
-			case "status":
-				params.Status = &vString
-			case "vrf":
-				params.Vrf = &vString
-			case "tenant":
-				params.Tenant = &vString
-			case "parent_prefix":
-				params.Parent = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredIPAddresses, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.IPAddress, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamIPAddressesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Ipam.IpamIPAddressesList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredIPAddresses) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredIPAddresses := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredIPAddresses {
 		var mapping = make(map[string]interface{})
@@ -200,7 +175,7 @@ func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) erro
 		mapping["description"] = v.Description
 		mapping["created"] = v.Created.String()
 		mapping["last_updated"] = v.LastUpdated.String()
-		mapping["custom_fields"] = v.CustomFields
+		mapping["custom_fields"] = api.getCustomFields(v.CustomFields)
 
 		mapping["ip_address"] = v.Address
 		mapping["address_family"] = v.Family.Label
@@ -1,7 +1,10 @@
 package netbox
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/netip"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
@@ -23,6 +26,32 @@ func dataSourceNetboxIPRange() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.IsCIDR,
 			},
+			"include_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to calculate the utilization of the IP range. This requires fetching all IP addresses within the IP range.",
+			},
+			"mark_utilized": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the IP range is marked as fully utilized. Only set if `include_utilization` is `true`.",
+			},
+			"utilization_percent": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the IP range in percent, calculated like in Netbox. Only set if `include_utilization` is `true`.",
+			},
+			"used_ips": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of addresses used by IP addresses. Only set if `include_utilization` is `true`.",
+			},
+			"total_ips": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of addresses in the IP range. Only set if `include_utilization` is `true`. Counts exceeding 2^63-1 are capped.",
+			},
 		},
 	}
 }
@@ -51,6 +80,41 @@ func dataSourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
+
+	d.Set("mark_utilized", nil)
+	d.Set("utilization_percent", nil)
+	d.Set("used_ips", nil)
+	d.Set("total_ips", nil)
+	if d.Get("include_utilization").(bool) {
+		ctx := context.Background()
+
+		// mark_utilized is not part of the IP range model of the API client
+		var ipRange struct {
+			MarkUtilized bool `json:"mark_utilized"`
+		}
+		if err := api.getRaw(ctx, fmt.Sprintf("ipam/ip-ranges/%d", result.ID), nil, &ipRange); err != nil {
+			return err
+		}
+		d.Set("mark_utilized", ipRange.MarkUtilized)
+
+		interval, err := parseIPRangeAddresses(*result.StartAddress, *result.EndAddress)
+		if err != nil {
+			return err
+		}
+		var childAddresses []netip.Addr
+		if !ipRange.MarkUtilized {
+			var vrfID int64
+			if result.Vrf != nil {
+				vrfID = result.Vrf.ID
+			}
+			childAddresses, err = api.listChildAddresses(ctx, interval.coveringPrefix(), &vrfID)
+			if err != nil {
+				return err
+			}
+		}
+		getIPRangeUtilization(interval, ipRange.MarkUtilized, childAddresses).setUtilization(d.Set)
+	}
+
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	return nil
 }
@@ -31,3 +31,46 @@ data "netbox_ip_range" "test" {
 		},
 	})
 }
+
+func TestAccNetboxIpRangeDataSource_utilization(t *testing.T) {
+	testSlug := "ip_range_ds_util"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ip_range" "test" {
+  start_address = "10.21.0.11/24"
+  end_address = "10.21.0.20/24"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_ip_address" "test" {
+  for_each = toset(["10.21.0.10/24", "10.21.0.11/24", "10.21.0.15/24"])
+  ip_address = each.value
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}
+
+data "netbox_ip_range" "test" {
+  depends_on = [netbox_ip_range.test, netbox_ip_address.test]
+  contains = "10.21.0.15/24"
+  include_utilization = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ip_range.test", "id", "netbox_ip_range.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "mark_utilized", "false"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "used_ips", "2"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "total_ips", "10"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "utilization_percent", "20"),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,8 +35,9 @@ func dataSourceNetboxIPRanges() *schema.Resource {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-				Default:          1000,
+				Description:      "The maximum number of IP ranges to return. All matching IP ranges are returned if unset. Earlier versions of the provider defaulted to `1000`.",
 			},
+			pageSizeKey: pageSizeSchema,
 			"ip_ranges": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -139,52 +140,29 @@ func dataSourceNetboxIPRangesRead(d *schema.ResourceData, m interface{}) error {
 
 	params := ipam.NewIpamIPRangesListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "contains":
-				params.Contains = &vString
-			case "start_address":
-				params.StartAddress = &vString
-			case "end_address":
-				params.EndAddress = &vString
-			case "role":
-				params.Role = &vString
-			case "status":
-				params.Status = &vString
-			case "vrf":
-				params.Vrf = &vString
-			case "tenant":
-				params.Tenant = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredIPRanges, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.IPRange, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamIPRangesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Ipam.IpamIPRangesList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredIPRanges) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredIPRanges := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredIPRanges {
 		var mapping = make(map[string]interface{})
@@ -193,7 +171,7 @@ func dataSourceNetboxIPRangesRead(d *schema.ResourceData, m interface{}) error {
 		mapping["description"] = v.Description
 		mapping["created"] = v.Created.String()
 		mapping["last_updated"] = v.LastUpdated.String()
-		mapping["custom_fields"] = v.CustomFields
+		mapping["custom_fields"] = api.getCustomFields(v.CustomFields)
 
 		mapping["start_address"] = v.StartAddress
 		mapping["end_address"] = v.EndAddress
@@ -0,0 +1,174 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxL2vpns() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxL2vpnsRead,
+		Description: `:meta:subcategory:L2VPN:`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Filters passed to the L2VPN list endpoint as query parameters, e.g. `type`, `identifier`, `tenant_id` or `tag`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			pageSizeKey: pageSizeSchema,
+			"l2vpns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"import_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"export_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxL2vpnsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		for _, f := range filter.(*schema.Set).List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			query.Add(k, v)
+		}
+	}
+
+	limit, pageSize := getListLimits(d)
+	filteredL2vpns, err := listAll(limit, pageSize, func(limit, offset *int64) ([]l2vpn, bool, error) {
+		pageQuery := cloneQuery(query)
+		if limit != nil {
+			pageQuery.Set("limit", strconv.FormatInt(*limit, 10))
+		}
+		if offset != nil {
+			pageQuery.Set("offset", strconv.FormatInt(*offset, 10))
+		}
+
+		var page struct {
+			Next    *string `json:"next"`
+			Results []l2vpn `json:"results"`
+		}
+		if err := api.getRaw(ctx, "vpn/l2vpns", pageQuery, &page); err != nil {
+			return nil, false, err
+		}
+		return page.Results, page.Next != nil, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(filteredL2vpns) == 0 {
+		return diag.FromErr(errors.New("no result"))
+	}
+
+	var s []map[string]interface{}
+	for _, v := range filteredL2vpns {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["name"] = v.Name
+		mapping["slug"] = v.Slug
+		if v.Type != nil {
+			mapping["type"] = v.Type.Value
+		}
+		if v.Identifier != nil {
+			mapping["identifier"] = *v.Identifier
+		}
+		mapping["import_target_ids"] = getIDsFromNestedRouteTargets(v.ImportTargets)
+		mapping["export_target_ids"] = getIDsFromNestedRouteTargets(v.ExportTargets)
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		mapping["description"] = v.Description
+		var tagIDs []int64
+		for _, t := range v.Tags {
+			tagIDs = append(tagIDs, t.ID)
+		}
+		mapping["tag_ids"] = tagIDs
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return diag.FromErr(d.Set("l2vpns", s))
+}
@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpnsDataSource_basic(t *testing.T) {
+	testSlug := "l2vpns_ds"
+	testName := testAccGetTestName(testSlug)
+	setUp := fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_l2vpn" "test_1" {
+  name       = "%[1]s-1"
+  type       = "vxlan-evpn"
+  identifier = 10201
+  tags       = [netbox_tag.test.name]
+}
+
+resource "netbox_l2vpn" "test_2" {
+  name       = "%[1]s-2"
+  type       = "vxlan-evpn"
+  identifier = 10202
+  tags       = [netbox_tag.test.name]
+}
+
+resource "netbox_l2vpn" "test_3" {
+  name = "%[1]s-3"
+  type = "vpls"
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+data "netbox_l2vpns" "by_tag" {
+  depends_on = [netbox_l2vpn.test_1, netbox_l2vpn.test_2, netbox_l2vpn.test_3]
+  filter {
+    name  = "tag"
+    value = netbox_tag.test.name
+  }
+}
+
+data "netbox_l2vpns" "by_identifier" {
+  depends_on = [netbox_l2vpn.test_1, netbox_l2vpn.test_2, netbox_l2vpn.test_3]
+  filter {
+    name  = "identifier"
+    value = "10202"
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_tag", "l2vpns.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_identifier", "l2vpns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_l2vpns.by_identifier", "l2vpns.0.id", "netbox_l2vpn.test_2", "id"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_identifier", "l2vpns.0.type", "vxlan-evpn"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_identifier", "l2vpns.0.identifier", "10202"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_identifier", "l2vpns.0.tag_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
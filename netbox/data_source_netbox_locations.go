@@ -1,10 +1,10 @@
 package netbox
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,7 +24,7 @@ func dataSourceNetboxLocations() *schema.Resource {
 						"name": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The name of the field to filter on. Supported fields are: .",
+							Description: "The name of the field to filter on. Any filter supported by the API endpoint can be used, including lookup expressions like `name__ic` or `status__n`. Custom fields can be filtered on with `cf_<name>`. A filter name can be given multiple times.",
 						},
 						"value": {
 							Type:        schema.TypeString,
@@ -49,6 +49,7 @@ func dataSourceNetboxLocations() *schema.Resource {
 				Default:          0,
 				Description:      "The limit of objects to return from the API lookup.",
 			},
+			pageSizeKey: pageSizeSchema,
 			"locations": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -101,37 +102,9 @@ func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error
 	api := m.(*providerState)
 	params := dcim.NewDcimLocationsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.Name = &vString
-			case "slug":
-				params.Slug = &vString
-			case "site":
-				params.Site = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "parent_id":
-				params.ParentID = &vString
-			case "tenant":
-				params.Tenant = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "status":
-				params.Status = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 	if tags, ok := d.GetOk("tags"); ok {
 		tagSet := tags.(*schema.Set)
@@ -140,14 +113,21 @@ func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error
 			params.Tag = append(params.Tag, tagV)
 		}
 	}
-	res, err := api.Dcim.DcimLocationsList(params, nil)
 
+	limit, pageSize := getListLimits(d)
+	filteredLocations, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.Location, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Dcim.DcimLocationsList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	filteredLocations := res.GetPayload().Results
-
 	var s []map[string]any
 	for _, v := range filteredLocations {
 		var mapping = make(map[string]any)
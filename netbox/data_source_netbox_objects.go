@@ -0,0 +1,108 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxObjectsRead,
+		Description: `:meta:subcategory:Extras:Queries any list endpoint of the Netbox API. This is useful for object types that are not supported by a dedicated data source.
+
+Each object is returned as a JSON string that can be decoded with ` + "`jsondecode()`" + `. No matching objects result in an empty list.`,
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The list endpoint to query, relative to the API root, e.g. `dcim/cables` or `extras/webhooks`.",
+			},
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Filters passed to the endpoint as query parameters. Any filter supported by the endpoint can be used, including lookup expressions like `name__ic` or `status__n`. A filter name can be given multiple times.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			pageSizeKey: pageSizeSchema,
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The object as returned by the API, encoded as JSON.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		for _, f := range filter.(*schema.Set).List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			query.Add(k, v)
+		}
+	}
+
+	limit, pageSize := getListLimits(d)
+	results, err := api.listRaw(ctx, d.Get("endpoint").(string), query, limit, pageSize)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s := make([]map[string]interface{}, 0, len(results))
+	for _, v := range results {
+		mapping := make(map[string]interface{})
+
+		if id, ok := getObjectID(v); ok {
+			mapping["id"] = id
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mapping["json"] = string(encoded)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return diag.FromErr(d.Set("objects", s))
+}
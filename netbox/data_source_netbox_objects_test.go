@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxObjectsDataSource_basic(t *testing.T) {
+	testSlug := "objects_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	setUp := fmt.Sprintf(`
+resource "netbox_manufacturer" "test_1" {
+  name = "%[1]s_1"
+}
+
+resource "netbox_manufacturer" "test_2" {
+  name = "%[1]s_2"
+}
+
+resource "netbox_manufacturer" "test_3" {
+  name = "%[1]s_3"
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+data "netbox_objects" "test" {
+  endpoint = "dcim/manufacturers"
+  filter {
+    name  = "name__isw"
+    value = "%[1]s"
+  }
+  filter {
+    name  = "name__n"
+    value = "%[1]s_3"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_objects.test", "objects.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_objects.test", "objects.0.id", "netbox_manufacturer.test_1", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_objects.test", "objects.1.id", "netbox_manufacturer.test_2", "id"),
+				),
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+data "netbox_objects" "test" {
+  endpoint  = "/api/dcim/manufacturers/"
+  page_size = 1
+  filter {
+    name  = "name__isw"
+    value = "%[1]s"
+  }
+}
+
+locals {
+  manufacturer = jsondecode(data.netbox_objects.test.objects[2].json)
+}
+
+output "manufacturer_name" {
+  value = local.manufacturer.name
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_objects.test", "objects.#", "3"),
+					resource.TestCheckOutput("manufacturer_name", testName+"_3"),
+				),
+			},
+			{
+				Config: setUp + `
+data "netbox_objects" "test" {
+  endpoint = "dcim/manufacturers"
+  filter {
+    name  = "name"
+    value = "this-manufacturer-does-not-exist"
+  }
+}`,
+				Check: resource.TestCheckResourceAttr("data.netbox_objects.test", "objects.#", "0"),
+			},
+		},
+	})
+}
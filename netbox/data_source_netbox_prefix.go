@@ -1,10 +1,13 @@
 package netbox
 
 import (
+	"context"
 	"errors"
+	"net/netip"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -112,6 +115,40 @@ for more information on available lookup expressions.`,
 				AtLeastOneOf: []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "tenant_id", "site_id", "role_id", "cidr", "tag", "status"},
 			},
 			"tags": tagsSchemaRead,
+			"is_pool": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mark_utilized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"child_prefix_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of prefixes within this prefix.",
+			},
+			"include_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to calculate the utilization of the prefix. This requires fetching all child prefixes or IP addresses of the prefix.",
+			},
+			"utilization_percent": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix in percent, calculated like in Netbox. Only set if `include_utilization` is `true`.",
+			},
+			"used_ips": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of used addresses. For containers, this is the number of addresses covered by child prefixes. Only set if `include_utilization` is `true`.",
+			},
+			"total_ips": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of usable addresses. The network and broadcast addresses of IPv4 prefixes are not counted unless the prefix is a pool or a container. Only set if `include_utilization` is `true`. Counts exceeding 2^63-1 are capped.",
+			},
 		},
 	}
 }
@@ -202,7 +239,7 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("family", int(*result.Family.Value))
 	d.Set("tags", getTagListFromNestedTagList(result.Tags))
 
-	cf := getCustomFields(result.CustomFields)
+	cf := api.getCustomFields(result.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -239,6 +276,64 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	d.Set("is_pool", result.IsPool)
+	d.Set("mark_utilized", result.MarkUtilized)
+	d.Set("child_prefix_count", result.Children)
+
+	d.Set("utilization_percent", nil)
+	d.Set("used_ips", nil)
+	d.Set("total_ips", nil)
+	if d.Get("include_utilization").(bool) {
+		utilization, err := getNetboxPrefixUtilization(api, result)
+		if err != nil {
+			return err
+		}
+		utilization.setUtilization(d.Set)
+	}
+
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	return nil
 }
+
+func getNetboxPrefixUtilization(api *providerState, result *models.Prefix) (ipUtilization, error) {
+	ctx := context.Background()
+
+	prefix, err := netip.ParsePrefix(*result.Prefix)
+	if err != nil {
+		return ipUtilization{}, err
+	}
+	isContainer := result.Status != nil && result.Status.Value != nil && *result.Status.Value == models.PrefixStatusValueContainer
+
+	var vrfID int64
+	if result.Vrf != nil {
+		vrfID = result.Vrf.ID
+	}
+	// Like in Netbox, containers in the global table include children of all VRFs
+	childVrfID := &vrfID
+	if isContainer && vrfID == 0 {
+		childVrfID = nil
+	}
+
+	var childPrefixes []netip.Prefix
+	var childRanges []ipInterval
+	var childAddresses []netip.Addr
+	if !result.MarkUtilized {
+		if isContainer {
+			childPrefixes, err = api.listChildPrefixes(ctx, prefix, childVrfID)
+			if err != nil {
+				return ipUtilization{}, err
+			}
+		} else {
+			childRanges, err = api.listUtilizedRanges(ctx, vrfID)
+			if err != nil {
+				return ipUtilization{}, err
+			}
+			childAddresses, err = api.listChildAddresses(ctx, prefix, childVrfID)
+			if err != nil {
+				return ipUtilization{}, err
+			}
+		}
+	}
+
+	return getPrefixUtilization(prefix, isContainer, result.IsPool, result.MarkUtilized, childPrefixes, childRanges, childAddresses), nil
+}
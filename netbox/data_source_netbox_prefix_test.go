@@ -175,3 +175,68 @@ data "netbox_prefix" "test_output" {
 		},
 	})
 }
+
+func TestAccNetboxPrefixDataSource_utilization(t *testing.T) {
+	testSlug := "prefix_ds_util"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "container" {
+  prefix = "10.20.0.0/16"
+  status = "container"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_prefix" "test" {
+  prefix = "10.20.0.0/24"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_ip_address" "test" {
+  count = 2
+  ip_address = "10.20.0.${count.index + 1}/24"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}
+
+data "netbox_prefix" "test" {
+  depends_on = [netbox_ip_address.test]
+  prefix = netbox_prefix.test.prefix
+  vrf_id = netbox_vrf.test.id
+  include_utilization = true
+}
+
+data "netbox_prefix" "container" {
+  depends_on = [netbox_prefix.test]
+  prefix = netbox_prefix.container.prefix
+  vrf_id = netbox_vrf.test.id
+  include_utilization = true
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_prefix.test", "used_ips", "2"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.test", "total_ips", "254"),
+					resource.TestCheckResourceAttrWith("data.netbox_prefix.test", "utilization_percent", func(value string) error {
+						if !strings.HasPrefix(value, "0.78") {
+							return fmt.Errorf("expected utilization of 0.78%%, got %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("data.netbox_prefix.test", "is_pool", "false"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.test", "mark_utilized", "false"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.container", "child_prefix_count", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.container", "used_ips", "256"),
+					resource.TestCheckResourceAttr("data.netbox_prefix.container", "total_ips", "65536"),
+				),
+			},
+		},
+	})
+}
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"fmt"
-	"strconv"
-
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,7 +22,7 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 						"name": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The name of the field to filter on. Supported fields are: `prefix`, `contains`, `vlan_vid`, `vrf_id`, `vlan_id`, `status`, `tenant_id`, `site_id`, `description` & `tag`.",
+							Description: "The name of the field to filter on. Any filter supported by the API endpoint can be used, including lookup expressions like `name__ic` or `status__n`. Custom fields can be filtered on with `cf_<name>`. A filter name can be given multiple times.",
 						},
 						"value": {
 							Type:        schema.TypeString,
@@ -41,6 +39,7 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 				Default:          0,
 				Description:      "The limit of objects to return from the API lookup.",
 			},
+			pageSizeKey: pageSizeSchema,
 			"prefixes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -107,54 +106,25 @@ func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
 
 	params := ipam.NewIpamPrefixesListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "prefix":
-				params.Prefix = &vString
-			case "vlan_vid":
-				float, err := strconv.ParseFloat(vString, 64)
-				if err != nil {
-					return err
-				}
-				params.VlanVid = &float
-			case "contains":
-				params.Contains = &vString
-			case "vrf_id":
-				params.VrfID = &vString
-			case "vlan_id":
-				params.VlanID = &vString
-			case "status":
-				params.Status = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "description":
-				params.Description = &vString
-			case "tag":
-				params.Tag = []string{vString}
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredPrefixes, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.Prefix, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamPrefixesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Ipam.IpamPrefixesList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	filteredPrefixes := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredPrefixes {
 		var mapping = make(map[string]interface{})
@@ -0,0 +1,199 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxRackUnits() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxRackUnitsRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Returns the units of a rack as seen from one face, together with the devices occupying them. Full-depth devices occupy the units on both faces.`,
+		Schema: map[string]*schema.Schema{
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"face": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "front",
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceRackFaceOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceRackFaceOptions) + " Defaults to `front`.",
+			},
+			"units": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The units of the rack, in ascending order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"occupied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"device_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the device occupying the unit. Not set if the unit is free.",
+						},
+					},
+				},
+			},
+			"free_units": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The free units, in ascending order.",
+				Elem: &schema.Schema{
+					Type: schema.TypeFloat,
+				},
+			},
+			"occupied_units": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The occupied units, in ascending order.",
+				Elem: &schema.Schema{
+					Type: schema.TypeFloat,
+				},
+			},
+		},
+	}
+}
+
+// rackUnit is a single unit of a rack elevation.
+type rackUnit struct {
+	unit     float64
+	name     string
+	occupied bool
+	deviceID int64
+}
+
+// getRackUnits returns the units of a rack as seen from the given face, in
+// ascending order. If excludeDeviceID is not 0, the units occupied by that
+// device are returned as free.
+func (s *providerState) getRackUnits(ctx context.Context, rackID int64, face string, excludeDeviceID int64) ([]rackUnit, error) {
+	query := url.Values{"face": {face}}
+	if excludeDeviceID != 0 {
+		query.Set("exclude", strconv.FormatInt(excludeDeviceID, 10))
+	}
+	results, err := s.listRaw(ctx, fmt.Sprintf("dcim/racks/%d/elevation", rackID), query, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	units := make([]rackUnit, 0, len(results))
+	for _, r := range results {
+		unit, err := strconv.ParseFloat(fmt.Sprint(r["id"]), 64)
+		if err != nil {
+			return nil, err
+		}
+		u := rackUnit{unit: unit, name: fmt.Sprint(r["name"])}
+		u.occupied, _ = r["occupied"].(bool)
+		if device, ok := r["device"].(map[string]interface{}); ok {
+			u.deviceID, _ = getRawID(device)
+		}
+		units = append(units, u)
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].unit < units[j].unit
+	})
+	return units, nil
+}
+
+// findFreeRackPosition returns the lowest, or if preferTop is set the
+// highest, position at which a device of the given height fits into the free
+// units. If the units of several faces are given, e.g. for full-depth
+// devices, a unit is only free if it is free on all of them.
+func findFreeRackPosition(height int, preferTop bool, faces ...[]rackUnit) (float64, error) {
+	free := map[float64]bool{}
+	var positions []float64
+	for i, units := range faces {
+		for _, u := range units {
+			if u.unit != math.Trunc(u.unit) {
+				continue
+			}
+			if i == 0 {
+				free[u.unit] = !u.occupied
+				positions = append(positions, u.unit)
+			} else {
+				free[u.unit] = free[u.unit] && !u.occupied
+			}
+		}
+	}
+	sort.Float64s(positions)
+	if preferTop {
+		sort.Sort(sort.Reverse(sort.Float64Slice(positions)))
+	}
+
+	for _, position := range positions {
+		fits := true
+		for i := 0; i < height; i++ {
+			if !free[position+float64(i)] {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			return position, nil
+		}
+	}
+	return 0, fmt.Errorf("no %d contiguous free rack units found", height)
+}
+
+func dataSourceNetboxRackUnitsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	rackID := int64(d.Get("rack_id").(int))
+	face := d.Get("face").(string)
+
+	units, err := api.getRackUnits(ctx, rackID, face, 0)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var s []map[string]interface{}
+	freeUnits := []float64{}
+	occupiedUnits := []float64{}
+	for _, u := range units {
+		mapping := map[string]interface{}{
+			"unit":     u.unit,
+			"name":     u.name,
+			"occupied": u.occupied,
+		}
+		if u.deviceID != 0 {
+			mapping["device_id"] = u.deviceID
+		}
+		s = append(s, mapping)
+
+		if u.occupied {
+			occupiedUnits = append(occupiedUnits, u.unit)
+		} else {
+			freeUnits = append(freeUnits, u.unit)
+		}
+	}
+
+	d.SetId(id.UniqueId())
+	if err := d.Set("units", s); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("free_units", freeUnits); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("occupied_units", occupiedUnits))
+}
@@ -0,0 +1,135 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxRackUnitsDataSource_basic(t *testing.T) {
+	testSlug := "rack_units_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_rack" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  status = "active"
+  width = 19
+  u_height = 10
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "front"
+  rack_position = 3
+}
+
+data "netbox_rack_units" "front" {
+  rack_id = netbox_rack.test.id
+  depends_on = [netbox_device.test]
+}
+
+data "netbox_rack_units" "rear" {
+  rack_id = netbox_rack.test.id
+  face = "rear"
+  depends_on = [netbox_device.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_rack_units.front", "units.#", "10"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.front", "units.2.unit", "3"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.front", "units.2.occupied", "true"),
+					resource.TestCheckResourceAttrPair("data.netbox_rack_units.front", "units.2.device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.front", "occupied_units.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.front", "occupied_units.0", "3"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.front", "free_units.#", "9"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.rear", "occupied_units.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindFreeRackPosition(t *testing.T) {
+	units := []rackUnit{
+		{unit: 1, occupied: true},
+		{unit: 2},
+		{unit: 3, occupied: true},
+		{unit: 4},
+		{unit: 5},
+		{unit: 6},
+		{unit: 7, occupied: true},
+		{unit: 8},
+	}
+	// A rear-mounted device in unit 2 blocks the first gap for full-depth devices.
+	rearUnits := []rackUnit{
+		{unit: 1, occupied: true},
+		{unit: 2, occupied: true},
+		{unit: 3, occupied: true},
+		{unit: 4},
+		{unit: 5},
+		{unit: 6},
+		{unit: 7, occupied: true},
+		{unit: 8},
+	}
+
+	for _, tt := range []struct {
+		name      string
+		height    int
+		preferTop bool
+		fullDepth bool
+		expected  float64
+		expectErr bool
+	}{
+		{name: "Bottom", height: 1, expected: 2},
+		{name: "Top", height: 1, preferTop: true, expected: 8},
+		{name: "MultipleUnitsBottom", height: 2, expected: 4},
+		{name: "MultipleUnitsTop", height: 2, preferTop: true, expected: 5},
+		{name: "NoSpace", height: 4, expectErr: true},
+		{name: "FullDepthBlockedByRear", height: 1, fullDepth: true, expected: 4},
+		{name: "FullDepthTop", height: 1, preferTop: true, fullDepth: true, expected: 8},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			faces := [][]rackUnit{units}
+			if tt.fullDepth {
+				faces = append(faces, rearUnits)
+			}
+			position, err := findFreeRackPosition(tt.height, tt.preferTop, faces...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, position)
+		})
+	}
+}
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +37,7 @@ func dataSourceNetboxRacks() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
 			},
+			pageSizeKey: pageSizeSchema,
 			"racks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -151,84 +152,31 @@ func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
 
 	params := dcim.NewDcimRacksListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, map[string]filterAlias{
+		"type_id": {name: "type"},
+	})
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "asset_tag":
-				params.AssetTag = &vString
-			case "contact":
-				params.Contact = &vString
-			case "contact_group":
-				params.ContactGroup = &vString
-			case "contact_role":
-				params.ContactRole = &vString
-			case "desc_units":
-				params.DescUnits = &vString
-			case "facility_id":
-				params.FacilityID = &vString
-			case "id":
-				params.ID = &vString
-			case "location_id":
-				params.LocationID = &vString
-			case "max_weight":
-				params.MaxWeight = &vString
-			case "mounting_depth":
-				params.MountingDepth = &vString
-			case "name":
-				params.Name = &vString
-			case "outer_depth":
-				params.OuterDepth = &vString
-			case "outer_unit":
-				params.OuterUnit = &vString
-			case "outer_width":
-				params.OuterWidth = &vString
-			case "region_id":
-				params.RegionID = &vString
-			case "role_id":
-				params.RoleID = &vString
-			case "serial":
-				params.Serial = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "status":
-				params.Status = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "type_id":
-				params.Type = &vString
-			case "u_height":
-				params.UHeight = &vString
-			case "weight":
-				params.Weight = &vString
-			case "weight_unit":
-				params.WeightUnit = &vString
-			case "width":
-				params.Width = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredRacks, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.Rack, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Dcim.DcimRacksList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Dcim.DcimRacksList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredRacks) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredRacks := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredRacks {
 		var mapping = make(map[string]interface{})
@@ -270,7 +218,7 @@ func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
 		mapping["mounting_depth"] = v.MountingDepth
 		mapping["description"] = v.Description
 		mapping["comments"] = v.Comments
-		mapping["custom_fields"] = getCustomFields(v.CustomFields)
+		mapping["custom_fields"] = api.getCustomFields(v.CustomFields)
 
 		s = append(s, mapping)
 	}
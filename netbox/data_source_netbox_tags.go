@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +37,7 @@ func dataSourceNetboxTags() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
 			},
+			pageSizeKey: pageSizeSchema,
 			"tags": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -74,68 +75,30 @@ func dataSourceNetboxTagsRead(d *schema.ResourceData, m interface{}) error {
 
 	params := extras.NewExtrasTagsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		filterParams := filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "id":
-				params.ID = &vString
-			case "id__gt":
-				params.IDGt = &vString
-			case "id__gte":
-				params.IDGte = &vString
-			case "id__lt":
-				params.IDLt = &vString
-			case "id__lte":
-				params.IDLte = &vString
-			case "name":
-				params.Name = &vString
-			case "name__ic":
-				params.NameIc = &vString
-			case "name__niew":
-				params.NameNiew = &vString
-			case "name__iew":
-				params.NameIew = &vString
-			case "name__nisw":
-				params.NameNisw = &vString
-			case "name__isw":
-				params.NameIsw = &vString
-			case "slug":
-				params.Slug = &vString
-			case "slug__ic":
-				params.SlugIc = &vString
-			case "slug__niew":
-				params.SlugNiew = &vString
-			case "slug__iew":
-				params.SlugIew = &vString
-			case "slug__nisw":
-				params.SlugNisw = &vString
-			case "slug__isw":
-				params.SlugIsw = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	results, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.Tag, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Extras.ExtrasTagsList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Extras.ExtrasTagsList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
 	var s []map[string]interface{}
-	results := res.GetPayload().Results
 	for _, v := range results {
 		mapping := make(map[string]interface{})
 
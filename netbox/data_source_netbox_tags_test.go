@@ -1,6 +1,7 @@
 package netbox
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -44,6 +45,44 @@ data "netbox_tags" "test" {
 }`
 }
 
+func testAccNetboxTagsBySlugs() string {
+	return `
+data "netbox_tags" "test" {
+  filter {
+    name  = "slug"
+    value = "tag1234"
+  }
+  filter {
+    name  = "slug"
+    value = "weird"
+  }
+}`
+}
+
+func testAccNetboxTagsByLookupExpression() string {
+	return `
+data "netbox_tags" "test" {
+  filter {
+    name  = "name__isw"
+    value = "tag123"
+  }
+  filter {
+    name  = "slug__n"
+    value = "tag1235"
+  }
+}`
+}
+
+func testAccNetboxTagsUnsupportedFilter() string {
+	return `
+data "netbox_tags" "test" {
+  filter {
+    name  = "foo"
+    value = "bar"
+  }
+}`
+}
+
 // func testAccNetboxTagsAll() string {
 // 	return `
 // data "netbox_tags" "test" {
@@ -75,6 +114,23 @@ func TestAccNetboxTagsDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.netbox_tags.test", "tags.0.tag_id", "netbox_tag.test_3", "id"),
 				),
 			},
+			{
+				Config: setUp + testAccNetboxTagsBySlugs(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_tags.test", "tags.#", "2"),
+				),
+			},
+			{
+				Config: setUp + testAccNetboxTagsByLookupExpression(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_tags.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_tags.test", "tags.0.tag_id", "netbox_tag.test_1", "id"),
+				),
+			},
+			{
+				Config:      setUp + testAccNetboxTagsUnsupportedFilter(),
+				ExpectError: regexp.MustCompile("'foo' is not a supported filter parameter"),
+			},
 			// {
 			// 	Config: setUp + testAccNetboxTagsAll(),
 			// 	Check: resource.ComposeTestCheckFunc(
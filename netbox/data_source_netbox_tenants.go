@@ -2,7 +2,6 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -36,8 +35,9 @@ func dataSourceNetboxTenants() *schema.Resource {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-				Default:          1000,
+				Description:      "The maximum number of tenants to return. All matching tenants are returned if unset. Earlier versions of the provider defaulted to `1000`.",
 			},
+			pageSizeKey: pageSizeSchema,
 			"tenants": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -152,38 +152,29 @@ func dataSourceNetboxTenantsRead(d *schema.ResourceData, m interface{}) error {
 
 	params := tenancy.NewTenancyTenantsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.Name = &vString
-			case "slug":
-				params.Slug = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredTenants, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.Tenant, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Tenancy.TenancyTenantsList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Tenancy.TenancyTenantsList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredTenants) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredTenants := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredTenants {
 		var mapping = make(map[string]interface{})
@@ -195,7 +186,7 @@ func dataSourceNetboxTenantsRead(d *schema.ResourceData, m interface{}) error {
 		mapping["created"] = v.Created.String()
 		mapping["last_updated"] = v.LastUpdated.String()
 		mapping["comments"] = v.Comments
-		mapping["custom_fields"] = v.CustomFields
+		mapping["custom_fields"] = api.getCustomFields(v.CustomFields)
 
 		mapping["site_count"] = v.SiteCount
 		mapping["rack_count"] = v.RackCount
@@ -1,7 +1,6 @@
 package netbox
 
 import (
-	"fmt"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
@@ -41,6 +40,7 @@ func dataSourceNetboxVirtualDisk() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			pageSizeKey: pageSizeSchema,
 			"virtual_disks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -86,32 +86,23 @@ func dataSourceNetboxVirtualDiskRead(d *schema.ResourceData, m interface{}) erro
 	api := m.(*providerState)
 	params := virtualization.NewVirtualizationVirtualDisksListParams()
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.([]interface{})
-		var tags []string
-		for _, f := range filterParams {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.NameIc = &vString
-			case "tag":
-				tags = append(tags, vString)
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
-		if len(tags) > 0 {
-			params.Tag = tags
-		}
-	}
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	query, err := getFilterQuery(d, params, map[string]filterAlias{
+		"name": {name: "name__ic"},
+	})
+	if err != nil {
+		return err
 	}
 
-	res, err := api.Virtualization.VirtualizationVirtualDisksList(params, nil)
+	limit, pageSize := getListLimits(d)
+	disks, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.VirtualDisk, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Virtualization.VirtualizationVirtualDisksList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
@@ -119,13 +110,13 @@ func dataSourceNetboxVirtualDiskRead(d *schema.ResourceData, m interface{}) erro
 	var filteredDisks []*models.VirtualDisk
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, disk := range res.GetPayload().Results {
+		for _, disk := range disks {
 			if disk.Name != nil && r.MatchString(*disk.Name) {
 				filteredDisks = append(filteredDisks, disk)
 			}
 		}
 	} else {
-		filteredDisks = res.GetPayload().Results
+		filteredDisks = disks
 	}
 
 	var s []map[string]interface{}
@@ -147,7 +138,7 @@ func dataSourceNetboxVirtualDiskRead(d *schema.ResourceData, m interface{}) erro
 			mapping["virtual_machine_id"] = v.VirtualMachine.ID
 		}
 		if v.CustomFields != nil {
-			mapping["custom_fields"] = v.CustomFields
+			mapping["custom_fields"] = api.getCustomFields(v.CustomFields)
 		}
 		if v.Tags != nil {
 			tags := []string{}
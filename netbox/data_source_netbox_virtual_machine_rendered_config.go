@@ -0,0 +1,20 @@
+package netbox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxVirtualMachineRenderedConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxVirtualMachineRenderedConfigRead,
+		Description: `:meta:subcategory:Extras:Renders the configuration of a virtual machine using its config template. The template is taken from the virtual machine, its role or its platform, in that order.`,
+		Schema:      renderedConfigSchema("virtual_machine_id", "The ID of the virtual machine to render the configuration for."),
+	}
+}
+
+func dataSourceNetboxVirtualMachineRenderedConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return readRenderedConfig(ctx, d, m.(*providerState), "virtualization/virtual-machines", d.Get("virtual_machine_id").(int))
+}
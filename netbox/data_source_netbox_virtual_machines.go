@@ -3,7 +3,6 @@ package netbox
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
@@ -43,6 +42,7 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			pageSizeKey: pageSizeSchema,
 			"vms": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -155,67 +155,44 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 
 	params := virtualization.NewVirtualizationVirtualMachinesListParams()
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "cluster_id":
-				params.ClusterID = &vString
-			case "cluster_group":
-				params.ClusterGroup = &vString
-			case "device_id":
-				params.Name = &vString
-			case "device":
-				params.Name = &vString
-			case "name":
-				params.Name = &vString
-			case "region":
-				params.Region = &vString
-			case "role":
-				params.Role = &vString
-			case "site":
-				params.Site = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			case "status":
-				params.Status = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
-	}
-
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	// device and device_id have always filtered by the name of the virtual
+	// machine, not by the device it runs on
+	query, err := getFilterQuery(d, params, map[string]filterAlias{
+		"device":    {name: "name"},
+		"device_id": {name: "name"},
+	})
+	if err != nil {
+		return err
 	}
 
-	res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil)
+	limit, pageSize := getListLimits(d)
+	vms, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.VirtualMachineWithConfigContext, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(vms) == 0 {
 		return errors.New("no result")
 	}
 
 	var filteredVms []*models.VirtualMachineWithConfigContext
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, vm := range res.GetPayload().Results {
+		for _, vm := range vms {
 			if r.MatchString(*vm.Name) {
 				filteredVms = append(filteredVms, vm)
 			}
 		}
 	} else {
-		filteredVms = res.GetPayload().Results
+		filteredVms = vms
 	}
 
 	var s []map[string]interface{}
@@ -240,7 +217,7 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 			}
 		}
 		if v.CustomFields != nil {
-			mapping["custom_fields"] = v.CustomFields
+			mapping["custom_fields"] = api.getCustomFields(v.CustomFields)
 		}
 		if v.Disk != nil {
 			mapping["disk_size_mb"] = *v.Disk
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +37,7 @@ func dataSourceNetboxVlans() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
 			},
+			pageSizeKey: pageSizeSchema,
 			"vlans": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -93,80 +94,29 @@ func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
 
 	params := ipam.NewIpamVlansListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "vid":
-				params.Vid = &vString
-			case "vid__gt":
-				params.VidGt = &vString
-			case "vid__gte":
-				params.VidGte = &vString
-			case "vid__lt":
-				params.VidLt = &vString
-			case "vid__lte":
-				params.VidLte = &vString
-			case "vid__n":
-				params.Vidn = &vString
-			case "group":
-				params.Group = &vString
-			case "group__n":
-				params.Groupn = &vString
-			case "group_id":
-				params.GroupID = &vString
-			case "group_id__n":
-				params.GroupIDn = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			case "tenant":
-				params.Tenant = &vString
-			case "tenant__n":
-				params.Tenantn = &vString
-			case "tenant_group":
-				params.TenantGroup = &vString
-			case "tenant_group__n":
-				params.TenantGroupn = &vString
-			case "tenant_group_id":
-				params.TenantGroupID = &vString
-			case "tenant_group_id__n":
-				params.TenantGroupIDn = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "tenant_id__n":
-				params.TenantIDn = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "site_id__n":
-				params.SiteIDn = &vString
-			case "status":
-				params.Status = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredVlans, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.VLAN, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamVlansList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Ipam.IpamVlansList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredVlans) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredVlans := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredVlans {
 		var mapping = make(map[string]interface{})
@@ -59,6 +59,41 @@ data "netbox_vlans" "test" {
 }`
 }
 
+func testAccNetboxVlansByVidRangePaged() string {
+	return `
+data "netbox_vlans" "test" {
+  page_size = 1
+
+  filter {
+	name = "vid__gte"
+	value = "1234"
+  }
+
+  filter {
+	name = "vid__lte"
+	value = "1236"
+  }
+}`
+}
+
+func testAccNetboxVlansByVidRangePagedWithLimit() string {
+	return `
+data "netbox_vlans" "test" {
+  page_size = 1
+  limit     = 2
+
+  filter {
+	name = "vid__gte"
+	value = "1234"
+  }
+
+  filter {
+	name = "vid__lte"
+	value = "1236"
+  }
+}`
+}
+
 func TestAccNetboxVlansDataSource_basic(t *testing.T) {
 	setUp := testAccNetboxVlansSetUp()
 	// This test cannot be run in parallel with other tests, because other tests create also Vlans
@@ -94,6 +129,21 @@ func TestAccNetboxVlansDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.netbox_vlans.test", "vlans.1.vid", "netbox_vlan.test_3", "vid"),
 				),
 			},
+			{
+				Config: setUp + testAccNetboxVlansByVidRangePaged(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_vlans.test", "vlans.#", "3"),
+					resource.TestCheckResourceAttrPair("data.netbox_vlans.test", "vlans.0.vid", "netbox_vlan.test_1", "vid"),
+					resource.TestCheckResourceAttrPair("data.netbox_vlans.test", "vlans.2.vid", "netbox_vlan.test_3", "vid"),
+				),
+			},
+			{
+				Config: setUp + testAccNetboxVlansByVidRangePagedWithLimit(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_vlans.test", "vlans.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_vlans.test", "vlans.1.vid", "netbox_vlan.test_2", "vid"),
+				),
+			},
 		},
 	})
 }
@@ -21,6 +21,20 @@ func dataSourceNetboxVrf() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"import_target_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"export_target_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 		},
 	}
 }
@@ -58,5 +72,7 @@ func dataSourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("import_target_ids", getIDsFromNestedRouteTargets(result.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTargets(result.ExportTargets))
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -41,6 +42,51 @@ func TestAccNetboxVrfDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetboxVrfDataSource_routeTargets(t *testing.T) {
+	testSlug := "vrf_ds_rt"
+	testName := testAccGetTestName(testSlug)
+	rtValue := acctest.RandIntRange(1, 1000000)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_route_target" "test" {
+  name = "65002:%[2]d"
+}
+
+resource "netbox_vrf" "test" {
+  name              = "%[1]s"
+  import_target_ids = [netbox_route_target.test.id]
+  export_target_ids = [netbox_route_target.test.id]
+}
+
+data "netbox_vrf" "test" {
+  depends_on = [netbox_vrf.test]
+  name       = "%[1]s"
+}
+
+data "netbox_vrfs" "test" {
+  depends_on = [netbox_vrf.test]
+  filter {
+    name  = "name"
+    value = "%[1]s"
+  }
+}`, testName, rtValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_vrf.test", "import_target_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_vrf.test", "import_target_ids.0", "netbox_route_target.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_vrf.test", "export_target_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_vrf.test", "export_target_ids.0", "netbox_route_target.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_vrfs.test", "vrfs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_vrfs.test", "vrfs.0.import_target_ids.0", "netbox_route_target.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_vrfs.test", "vrfs.0.export_target_ids.0", "netbox_route_target.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNetboxVrfSetUp(testName string) string {
 	return fmt.Sprintf(`
 resource"netbox_vrf" "test" {
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +37,7 @@ func dataSourceNetboxVrfs() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
 			},
+			pageSizeKey: pageSizeSchema,
 			"vrfs": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -62,6 +63,20 @@ func dataSourceNetboxVrfs() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"import_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"export_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
 					},
 				},
 			},
@@ -74,62 +89,29 @@ func dataSourceNetboxVrfsRead(d *schema.ResourceData, m interface{}) error {
 
 	params := ipam.NewIpamVrfsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	query, err := getFilterQuery(d, params, nil)
+	if err != nil {
+		return err
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "id":
-				params.ID = &vString
-			case "name":
-				params.Name = &vString
-			case "description":
-				params.Description = &vString
-			case "rd":
-				params.Rd = &vString
-			case "tenant":
-				params.Tenant = &vString
-			case "tenant__n":
-				params.Tenantn = &vString
-			case "tenant_group":
-				params.TenantGroup = &vString
-			case "tenant_group__n":
-				params.TenantGroupn = &vString
-			case "tenant_group_id":
-				params.TenantGroupID = &vString
-			case "tenant_group_id__n":
-				params.TenantGroupIDn = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "tenant_id__n":
-				params.TenantIDn = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	limit, pageSize := getListLimits(d)
+	filteredVrfs, err := listAll(limit, pageSize, func(limit, offset *int64) ([]*models.VRF, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamVrfsList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
 		}
-	}
-
-	res, err := api.Ipam.IpamVrfsList(params, nil)
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(filteredVrfs) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredVrfs := res.GetPayload().Results
-
 	var s []map[string]interface{}
 	for _, v := range filteredVrfs {
 		var mapping = make(map[string]interface{})
@@ -143,6 +125,8 @@ func dataSourceNetboxVrfsRead(d *schema.ResourceData, m interface{}) error {
 		if v.Tenant != nil {
 			mapping["tenant"] = v.Tenant.ID
 		}
+		mapping["import_target_ids"] = getIDsFromNestedRouteTargets(v.ImportTargets)
+		mapping["export_target_ids"] = getIDsFromNestedRouteTargets(v.ExportTargets)
 
 		s = append(s, mapping)
 	}
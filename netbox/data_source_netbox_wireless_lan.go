@@ -0,0 +1,117 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxWirelessLan() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxWirelessLanRead,
+		Description: `:meta:subcategory:Wireless:`,
+		Schema: map[string]*schema.Schema{
+			"ssid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Narrows the search to wireless LANs of this group.",
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Narrows the search to wireless LANs bridged to this VLAN.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"site_group_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"location_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"region_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_cipher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_psk": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxWirelessLanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{
+		"ssid":  {d.Get("ssid").(string)},
+		"limit": {"2"}, // Limit of 2 is enough
+	}
+	if groupID, ok := d.GetOk("group_id"); ok {
+		query.Set("group_id", strconv.Itoa(groupID.(int)))
+	}
+	if vlanID, ok := d.GetOk("vlan_id"); ok {
+		query.Set("vlan_id", strconv.Itoa(vlanID.(int)))
+	}
+
+	var page struct {
+		Count   int64         `json:"count"`
+		Results []wirelessLan `json:"results"`
+	}
+	if err := api.getRaw(ctx, "wireless/wireless-lans", query, &page); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if page.Count > int64(1) {
+		return diag.FromErr(errors.New("more than one wireless LAN returned, specify a more narrow filter"))
+	}
+	if page.Count == int64(0) {
+		return diag.FromErr(errors.New("no wireless LAN found matching filter"))
+	}
+	result := page.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	setWirelessLanResourceData(d, &result)
+	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,35 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLanGroupDataSource_basic(t *testing.T) {
+	testSlug := "wlan_grp_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_wireless_lan_group" "test" {
+  name        = "%[1]s"
+  description = "test"
+}
+
+data "netbox_wireless_lan_group" "test" {
+  depends_on = [netbox_wireless_lan_group.test]
+  name       = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan_group.test", "id", "netbox_wireless_lan_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan_group.test", "slug", "netbox_wireless_lan_group.test", "slug"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_lan_group.test", "description", "test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLanDataSource_basic(t *testing.T) {
+	testSlug := "wlan_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_wireless_lan_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_wireless_lan" "test" {
+  ssid      = "%[1]s"
+  group_id  = netbox_wireless_lan_group.test.id
+  auth_type = "open"
+}
+
+data "netbox_wireless_lan" "test" {
+  depends_on = [netbox_wireless_lan.test]
+  ssid       = "%[1]s"
+  group_id   = netbox_wireless_lan_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan.test", "id", "netbox_wireless_lan.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan.test", "group_id", "netbox_wireless_lan_group.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_lan.test", "status", "active"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_lan.test", "auth_type", "open"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxWirelessLink() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxWirelessLinkRead,
+		Description: `:meta:subcategory:Wireless:`,
+		Schema: map[string]*schema.Schema{
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"ssid", "interface_a_id", "interface_b_id"},
+			},
+			"interface_a_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"ssid", "interface_a_id", "interface_b_id"},
+			},
+			"interface_b_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"ssid", "interface_a_id", "interface_b_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_cipher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_psk": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"distance": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"distance_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxWirelessLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{
+		"limit": {"2"}, // Limit of 2 is enough
+	}
+	if ssid, ok := d.GetOk("ssid"); ok {
+		query.Set("ssid", ssid.(string))
+	}
+	if interfaceAID, ok := d.GetOk("interface_a_id"); ok {
+		query.Set("interface_a_id", strconv.Itoa(interfaceAID.(int)))
+	}
+	if interfaceBID, ok := d.GetOk("interface_b_id"); ok {
+		query.Set("interface_b_id", strconv.Itoa(interfaceBID.(int)))
+	}
+
+	var page struct {
+		Count   int64          `json:"count"`
+		Results []wirelessLink `json:"results"`
+	}
+	if err := api.getRaw(ctx, "wireless/wireless-links", query, &page); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if page.Count > int64(1) {
+		return diag.FromErr(errors.New("more than one wireless link returned, specify a more narrow filter"))
+	}
+	if page.Count == int64(0) {
+		return diag.FromErr(errors.New("no wireless link found matching filter"))
+	}
+	result := page.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	setWirelessLinkResourceData(d, &result)
+	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+	return nil
+}
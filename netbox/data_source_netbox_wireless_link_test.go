@@ -0,0 +1,37 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLinkDataSource_basic(t *testing.T) {
+	testSlug := "wlink_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxWirelessLinkFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_wireless_link" "test" {
+  interface_a_id = netbox_device_interface.a.id
+  interface_b_id = netbox_device_interface.b.id
+  ssid           = "%[1]s"
+}
+
+data "netbox_wireless_link" "test" {
+  depends_on     = [netbox_wireless_link.test]
+  interface_a_id = netbox_device_interface.a.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.test", "id", "netbox_wireless_link.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.test", "interface_b_id", "netbox_device_interface.b", "id"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.test", "ssid", testName),
+					resource.TestCheckResourceAttr("data.netbox_wireless_link.test", "status", "connected"),
+				),
+			},
+		},
+	})
+}
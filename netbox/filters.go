@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customFieldFilterPrefix is the prefix of filters on custom fields. Those
+// filters are not part of the OpenAPI specification and are always forwarded.
+const customFieldFilterPrefix = "cf_"
+
+// listParams is implemented by the list parameters generated by go-netbox.
+type listParams interface {
+	WriteToRequest(runtime.ClientRequest, strfmt.Registry) error
+}
+
+// filterAlias maps a filter name that is kept for backwards compatibility
+// to the query parameter it is forwarded as.
+type filterAlias struct {
+	name string
+	// separator splits the value into multiple values if set.
+	separator string
+}
+
+var (
+	filterNamesMu    sync.Mutex
+	filterNamesCache = map[reflect.Type]map[string]bool{}
+)
+
+// getFilterNames returns the query parameters of the list endpoint the given
+// parameters belong to, as declared in the OpenAPI specification. The
+// parameters managed by the provider itself, i.e. limit and offset, are
+// excluded.
+func getFilterNames(params listParams) map[string]bool {
+	t := reflect.TypeOf(params)
+
+	filterNamesMu.Lock()
+	defer filterNamesMu.Unlock()
+	if names, ok := filterNamesCache[t]; ok {
+		return names
+	}
+
+	// Setting every field and recording the query parameters written to the
+	// request yields the names without maintaining a list per endpoint.
+	p := reflect.New(t.Elem())
+	v := p.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.Ptr:
+			elem := reflect.New(field.Type().Elem())
+			if !setDummyValue(elem.Elem()) {
+				continue
+			}
+			field.Set(elem)
+		case reflect.Slice:
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if !setDummyValue(elem) {
+				continue
+			}
+			field.Set(reflect.Append(field, elem))
+		}
+	}
+
+	recorder := &queryRecorder{query: url.Values{}}
+	names := map[string]bool{}
+	if err := p.Interface().(listParams).WriteToRequest(recorder, strfmt.Default); err == nil {
+		for name := range recorder.query {
+			names[name] = true
+		}
+	}
+	delete(names, "limit")
+	delete(names, "offset")
+
+	filterNamesCache[t] = names
+	return names
+}
+
+func setDummyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("1")
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Bool:
+		v.SetBool(true)
+	default:
+		return false
+	}
+	return true
+}
+
+// queryRecorder is a runtime.ClientRequest that only records query parameters.
+type queryRecorder struct {
+	runtime.TestClientRequest
+	query url.Values
+}
+
+func (r *queryRecorder) SetQueryParam(name string, values ...string) error {
+	r.query[name] = values
+	return nil
+}
+
+func (r *queryRecorder) GetQueryParams() url.Values {
+	return r.query
+}
+
+// getFilterQuery converts the filter attribute of a plural data source into
+// query parameters for the list endpoint the given parameters belong to.
+// Filters given multiple times result in a repeated query parameter. Filter
+// names are validated against the parameters of the endpoint, if those are
+// known.
+func getFilterQuery(d *schema.ResourceData, params listParams, aliases map[string]filterAlias) (url.Values, error) {
+	return getFilterQueryFromKey(d, "filter", params, aliases)
+}
+
+// getFilterQueryFromKey is like getFilterQuery, but reads the filters from
+// the given attribute.
+func getFilterQueryFromKey(d interface {
+	GetOk(string) (interface{}, bool)
+}, key string, params listParams, aliases map[string]filterAlias) (url.Values, error) {
+	query := url.Values{}
+
+	filter, ok := d.GetOk(key)
+	if !ok {
+		return query, nil
+	}
+	var filters []interface{}
+	switch f := filter.(type) {
+	case *schema.Set:
+		filters = f.List()
+	case []interface{}:
+		filters = f
+	}
+
+	names := getFilterNames(params)
+	for _, f := range filters {
+		name := f.(map[string]interface{})["name"].(string)
+		v := f.(map[string]interface{})["value"].(string)
+
+		k, values := name, []string{v}
+		if alias, ok := aliases[name]; ok {
+			k = alias.name
+			if alias.separator != "" {
+				values = strings.Split(v, alias.separator)
+			}
+		}
+		if len(names) > 0 && !names[k] && !strings.HasPrefix(k, customFieldFilterPrefix) {
+			return nil, fmt.Errorf("'%s' is not a supported filter parameter", name)
+		}
+		for _, value := range values {
+			query.Add(k, value)
+		}
+	}
+	return query, nil
+}
+
+// withQuery returns a client option that adds the given query parameters to
+// the request, after the parameters of the operation have been written.
+func withQuery(query url.Values) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		if len(query) == 0 {
+			return
+		}
+		params := op.Params
+		op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			if params != nil {
+				if err := params.WriteToRequest(r, reg); err != nil {
+					return err
+				}
+			}
+			for k, v := range query {
+				if err := r.SetQueryParam(k, v...); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+}
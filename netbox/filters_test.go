@@ -0,0 +1,122 @@
+package netbox
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFilterNames(t *testing.T) {
+	names := getFilterNames(dcim.NewDcimDevicesListParams())
+
+	for _, name := range []string{"name", "name__ic", "status__n", "tag", "site_id", "ordering"} {
+		assert.True(t, names[name], name)
+	}
+	assert.False(t, names["limit"])
+	assert.False(t, names["offset"])
+	assert.False(t, names["prefix"])
+}
+
+func TestGetFilterQuery(t *testing.T) {
+	filterSchema := map[string]*schema.Schema{
+		"filter": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name":  {Type: schema.TypeString, Required: true},
+					"value": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+	}
+	aliases := map[string]filterAlias{
+		"ip_address": {name: "address"},
+		"tags":       {name: "tag", separator: ","},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		filters  []interface{}
+		expected url.Values
+		err      string
+	}{
+		{
+			name:     "empty",
+			expected: url.Values{},
+		},
+		{
+			name: "lookup expressions",
+			filters: []interface{}{
+				map[string]interface{}{"name": "dns_name__ic", "value": "example"},
+				map[string]interface{}{"name": "status__n", "value": "deprecated"},
+			},
+			expected: url.Values{"dns_name__ic": {"example"}, "status__n": {"deprecated"}},
+		},
+		{
+			name: "repeated",
+			filters: []interface{}{
+				map[string]interface{}{"name": "status", "value": "active"},
+				map[string]interface{}{"name": "status", "value": "reserved"},
+			},
+			expected: url.Values{"status": {"active", "reserved"}},
+		},
+		{
+			name: "aliases",
+			filters: []interface{}{
+				map[string]interface{}{"name": "ip_address", "value": "10.0.0.1/24"},
+				map[string]interface{}{"name": "tags", "value": "a,b"},
+			},
+			expected: url.Values{"address": {"10.0.0.1/24"}, "tag": {"a", "b"}},
+		},
+		{
+			name: "custom field",
+			filters: []interface{}{
+				map[string]interface{}{"name": "cf_owner", "value": "me"},
+			},
+			expected: url.Values{"cf_owner": {"me"}},
+		},
+		{
+			name: "unsupported",
+			filters: []interface{}{
+				map[string]interface{}{"name": "foo", "value": "bar"},
+			},
+			err: "'foo' is not a supported filter parameter",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, filterSchema, map[string]interface{}{"filter": tt.filters})
+
+			query, err := getFilterQuery(d, ipam.NewIpamIPAddressesListParams(), aliases)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, query)
+		})
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	params := ipam.NewIpamPrefixesListParams()
+	limit := int64(10)
+	params.Limit = &limit
+
+	op := &runtime.ClientOperation{Params: params}
+	withQuery(url.Values{"prefix__n": {"10.0.0.0/8"}, "tag": {"a", "b"}})(op)
+
+	recorder := &queryRecorder{query: url.Values{}}
+	assert.NoError(t, op.Params.WriteToRequest(recorder, strfmt.Default))
+	assert.Equal(t, url.Values{
+		"limit":     {"10"},
+		"prefix__n": {"10.0.0.0/8"},
+		"tag":       {"a", "b"},
+	}, recorder.query)
+}
@@ -0,0 +1,471 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// configGenResource describes how objects of a resource type are exported by
+// GenerateConfig.
+type configGenResource struct {
+	// path is the list endpoint of the objects
+	path string
+
+	// references maps attributes holding IDs of other objects to the resource
+	// type of these objects, in addition to defaultConfigGenReferences
+	references map[string]string
+}
+
+// defaultConfigGenReferences maps attributes commonly holding IDs of other
+// objects to the resource type of these objects.
+var defaultConfigGenReferences = map[string]string{
+	"cluster_group_id":   "netbox_cluster_group",
+	"cluster_id":         "netbox_cluster",
+	"cluster_type_id":    "netbox_cluster_type",
+	"device_id":          "netbox_device",
+	"device_type_id":     "netbox_device_type",
+	"location_id":        "netbox_location",
+	"manufacturer_id":    "netbox_manufacturer",
+	"platform_id":        "netbox_platform",
+	"rack_id":            "netbox_rack",
+	"region_id":          "netbox_region",
+	"rir_id":             "netbox_rir",
+	"site_group_id":      "netbox_site_group",
+	"site_id":            "netbox_site",
+	"tenant_id":          "netbox_tenant",
+	"virtual_machine_id": "netbox_virtual_machine",
+	"vlan_id":            "netbox_vlan",
+	"vrf_id":             "netbox_vrf",
+}
+
+// configGenResources are the resource types supported by GenerateConfig, in
+// the order they are written.
+var configGenResources = []struct {
+	name string
+	configGenResource
+}{
+	{"netbox_tag", configGenResource{path: "extras/tags"}},
+	{"netbox_tenant_group", configGenResource{path: "tenancy/tenant-groups", references: map[string]string{"parent_id": "netbox_tenant_group"}}},
+	{"netbox_tenant", configGenResource{path: "tenancy/tenants", references: map[string]string{"group_id": "netbox_tenant_group"}}},
+	{"netbox_rir", configGenResource{path: "ipam/rirs"}},
+	{"netbox_asn", configGenResource{path: "ipam/asns"}},
+	{"netbox_asn_range", configGenResource{path: "ipam/asn-ranges"}},
+	{"netbox_region", configGenResource{path: "dcim/regions", references: map[string]string{"parent_region_id": "netbox_region"}}},
+	{"netbox_site_group", configGenResource{path: "dcim/site-groups", references: map[string]string{"parent_id": "netbox_site_group"}}},
+	{"netbox_site", configGenResource{path: "dcim/sites", references: map[string]string{"group_id": "netbox_site_group", "asn_ids": "netbox_asn"}}},
+	{"netbox_location", configGenResource{path: "dcim/locations", references: map[string]string{"parent_id": "netbox_location"}}},
+	{"netbox_rack_role", configGenResource{path: "dcim/rack-roles"}},
+	{"netbox_rack", configGenResource{path: "dcim/racks", references: map[string]string{"role_id": "netbox_rack_role"}}},
+	{"netbox_manufacturer", configGenResource{path: "dcim/manufacturers"}},
+	{"netbox_platform", configGenResource{path: "dcim/platforms"}},
+	{"netbox_device_role", configGenResource{path: "dcim/device-roles"}},
+	{"netbox_device_type", configGenResource{path: "dcim/device-types"}},
+	{"netbox_cluster_type", configGenResource{path: "virtualization/cluster-types"}},
+	{"netbox_cluster_group", configGenResource{path: "virtualization/cluster-groups"}},
+	{"netbox_cluster", configGenResource{path: "virtualization/clusters"}},
+	{"netbox_device", configGenResource{path: "dcim/devices", references: map[string]string{"role_id": "netbox_device_role"}}},
+	{"netbox_device_interface", configGenResource{path: "dcim/interfaces", references: map[string]string{
+		"lag_device_interface_id":    "netbox_device_interface",
+		"parent_device_interface_id": "netbox_device_interface",
+		"tagged_vlans":               "netbox_vlan",
+		"untagged_vlan":              "netbox_vlan",
+	}}},
+	{"netbox_virtual_machine", configGenResource{path: "virtualization/virtual-machines", references: map[string]string{"role_id": "netbox_device_role"}}},
+	{"netbox_interface", configGenResource{path: "virtualization/interfaces", references: map[string]string{
+		"tagged_vlans":  "netbox_vlan",
+		"untagged_vlan": "netbox_vlan",
+	}}},
+	{"netbox_route_target", configGenResource{path: "ipam/route-targets"}},
+	{"netbox_vrf", configGenResource{path: "ipam/vrfs", references: map[string]string{
+		"export_target_ids": "netbox_route_target",
+		"import_target_ids": "netbox_route_target",
+	}}},
+	{"netbox_ipam_role", configGenResource{path: "ipam/roles"}},
+	{"netbox_vlan_group", configGenResource{path: "ipam/vlan-groups"}},
+	{"netbox_vlan", configGenResource{path: "ipam/vlans", references: map[string]string{"group_id": "netbox_vlan_group", "role_id": "netbox_ipam_role"}}},
+	{"netbox_aggregate", configGenResource{path: "ipam/aggregates"}},
+	{"netbox_prefix", configGenResource{path: "ipam/prefixes", references: map[string]string{"role_id": "netbox_ipam_role"}}},
+	{"netbox_ip_range", configGenResource{path: "ipam/ip-ranges", references: map[string]string{"role_id": "netbox_ipam_role"}}},
+	{"netbox_ip_address", configGenResource{path: "ipam/ip-addresses", references: map[string]string{
+		"device_interface_id":          "netbox_device_interface",
+		"nat_inside_address_id":        "netbox_ip_address",
+		"virtual_machine_interface_id": "netbox_interface",
+	}}},
+}
+
+// ConfigGenResourceTypes returns the resource types supported by
+// GenerateConfig.
+func ConfigGenResourceTypes() []string {
+	types := make([]string, 0, len(configGenResources))
+	for _, r := range configGenResources {
+		types = append(types, r.name)
+	}
+	return types
+}
+
+// GenerateConfigOptions control which objects are exported by GenerateConfig.
+type GenerateConfigOptions struct {
+	// ResourceTypes are the resource types to export, all supported types if empty
+	ResourceTypes []string
+
+	// Filters are query parameters of the list endpoints by resource type.
+	// Filters for the resource type "" apply to all resource types.
+	Filters map[string]url.Values
+}
+
+// configGenObject is a single object exported by GenerateConfig.
+type configGenObject struct {
+	resourceType string
+	label        string
+	data         *schema.ResourceData
+}
+
+// GenerateConfig exports existing Netbox objects as Terraform configuration,
+// consisting of an import block and a resource block per object. Attributes
+// referring to other exported objects are written as references. The
+// provider is configured from the usual environment variables.
+func GenerateConfig(ctx context.Context, w io.Writer, opts GenerateConfigOptions) error {
+	provider := Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return diagsToError(diags)
+	}
+	api := provider.Meta().(*providerState)
+
+	resourceTypes := opts.ResourceTypes
+	if len(resourceTypes) == 0 {
+		resourceTypes = ConfigGenResourceTypes()
+	}
+	for _, resourceType := range resourceTypes {
+		if _, ok := getConfigGenResource(resourceType); !ok {
+			return fmt.Errorf("resource type %s is not supported, supported types are: %s", resourceType, strings.Join(ConfigGenResourceTypes(), ", "))
+		}
+	}
+
+	g := newConfigGenerator()
+	for _, r := range configGenResources {
+		if !slices.Contains(resourceTypes, r.name) {
+			continue
+		}
+
+		query := url.Values{}
+		for key, values := range opts.Filters[""] {
+			query[key] = values
+		}
+		for key, values := range opts.Filters[r.name] {
+			query[key] = values
+		}
+		query.Set("brief", "true")
+
+		results, err := api.listRaw(ctx, r.path, query, 0, 0)
+		if err != nil {
+			return fmt.Errorf("error listing objects of %s: %w", r.name, err)
+		}
+
+		res := provider.ResourcesMap[r.name]
+		for _, result := range results {
+			id, ok := getObjectID(result)
+			if !ok {
+				continue
+			}
+
+			d := res.TestResourceData()
+			d.SetId(strconv.FormatInt(id, 10))
+			if diags := readResource(ctx, res, d, api); diags.HasError() {
+				return fmt.Errorf("error reading %s %d: %w", r.name, id, diagsToError(diags))
+			}
+			if d.Id() == "" {
+				continue // deleted in the meantime
+			}
+			g.add(r.name, d)
+		}
+	}
+
+	_, err := w.Write(g.render(provider))
+	return err
+}
+
+func getConfigGenResource(name string) (configGenResource, bool) {
+	for _, r := range configGenResources {
+		if r.name == name {
+			return r.configGenResource, true
+		}
+	}
+	return configGenResource{}, false
+}
+
+// readResource calls the read function of a resource, regardless of the kind
+// of read function it defines.
+func readResource(ctx context.Context, res *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch {
+	case res.ReadContext != nil:
+		return res.ReadContext(ctx, d, meta)
+	case res.ReadWithoutTimeout != nil:
+		return res.ReadWithoutTimeout(ctx, d, meta)
+	case res.Read != nil:
+		return diag.FromErr(res.Read(d, meta))
+	}
+	return diag.Errorf("resource has no read function")
+}
+
+func diagsToError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			messages = append(messages, d.Summary)
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+// configGenerator collects exported objects and renders them as HCL.
+type configGenerator struct {
+	objects []configGenObject
+
+	// labels maps resource types and IDs to the labels of the exported objects
+	labels map[string]map[string]string
+
+	usedLabels map[string]bool
+}
+
+func newConfigGenerator() *configGenerator {
+	return &configGenerator{
+		labels:     make(map[string]map[string]string),
+		usedLabels: make(map[string]bool),
+	}
+}
+
+var configGenLabelAttributes = []string{"slug", "name", "prefix", "ip_address", "start_address", "asn", "vid"}
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// add registers an object and assigns a unique label to it.
+func (g *configGenerator) add(resourceType string, d *schema.ResourceData) {
+	label := ""
+	for _, attribute := range configGenLabelAttributes {
+		if value, ok := d.GetOk(attribute); ok {
+			label = sanitizeLabel(fmt.Sprintf("%v", value))
+			break
+		}
+	}
+	if label == "" {
+		label = "id_" + d.Id()
+	}
+	if g.usedLabels[resourceType+"."+label] {
+		label = label + "_" + d.Id()
+	}
+	g.usedLabels[resourceType+"."+label] = true
+
+	if g.labels[resourceType] == nil {
+		g.labels[resourceType] = make(map[string]string)
+	}
+	g.labels[resourceType][d.Id()] = label
+	g.objects = append(g.objects, configGenObject{
+		resourceType: resourceType,
+		label:        label,
+		data:         d,
+	})
+}
+
+// sanitizeLabel turns an arbitrary string into a valid resource label.
+func sanitizeLabel(s string) string {
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if label == "" {
+		return ""
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+	return label
+}
+
+func (g *configGenerator) render(provider *schema.Provider) []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, object := range g.objects {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		importBlock := body.AppendNewBlock("import", nil).Body()
+		importBlock.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: object.resourceType},
+			hcl.TraverseAttr{Name: object.label},
+		})
+		importBlock.SetAttributeValue("id", cty.StringVal(object.data.Id()))
+		body.AppendNewline()
+
+		r, _ := getConfigGenResource(object.resourceType)
+		resourceBlock := body.AppendNewBlock("resource", []string{object.resourceType, object.label}).Body()
+		g.renderAttributes(resourceBlock, provider.ResourcesMap[object.resourceType].Schema, r.references, func(key string) interface{} {
+			return object.data.Get(key)
+		})
+	}
+
+	return f.Bytes()
+}
+
+// renderAttributes writes all configurable attributes holding a non-default
+// value to the body. Required attributes are written first.
+func (g *configGenerator) renderAttributes(body *hclwrite.Body, s map[string]*schema.Schema, references map[string]string, get func(key string) interface{}) {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		if s[a].Required != s[b].Required {
+			if s[a].Required {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	written := make(map[string]bool)
+	for _, key := range keys {
+		attr := s[key]
+		if (!attr.Required && !attr.Optional) || attr.Deprecated != "" || key == tagsAllKey {
+			continue
+		}
+		if slices.ContainsFunc(attr.ConflictsWith, func(other string) bool { return written[other] }) {
+			continue
+		}
+
+		value := get(key)
+		if !attr.Required && isDefaultValue(attr, value) {
+			continue
+		}
+
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			for _, item := range collectionItems(value) {
+				item, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				block := body.AppendNewBlock(key, nil).Body()
+				g.renderAttributes(block, elem.Schema, nil, func(key string) interface{} {
+					return item[key]
+				})
+			}
+			written[key] = true
+			continue
+		}
+
+		target := references[key]
+		if target == "" {
+			target = defaultConfigGenReferences[key]
+		}
+		body.SetAttributeRaw(key, g.valueTokens(attr, value, target))
+		written[key] = true
+	}
+
+	// attributes that are only valid together with other attributes are
+	// dropped if these were not written
+	for _, key := range keys {
+		if written[key] && slices.ContainsFunc(s[key].RequiredWith, func(other string) bool { return !written[other] }) {
+			body.RemoveAttribute(key)
+		}
+	}
+}
+
+// valueTokens returns the tokens of an attribute value. IDs of exported
+// objects of the target resource type are written as references.
+func (g *configGenerator) valueTokens(attr *schema.Schema, value interface{}, target string) hclwrite.Tokens {
+	switch attr.Type {
+	case schema.TypeList, schema.TypeSet:
+		var elemAttr *schema.Schema
+		if e, ok := attr.Elem.(*schema.Schema); ok {
+			elemAttr = e
+		} else {
+			elemAttr = &schema.Schema{Type: schema.TypeString}
+		}
+		items := collectionItems(value)
+		tokens := make([]hclwrite.Tokens, 0, len(items))
+		for _, item := range items {
+			tokens = append(tokens, g.valueTokens(elemAttr, item, target))
+		}
+		return hclwrite.TokensForTuple(tokens)
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		values := make(map[string]cty.Value, len(m))
+		for k, v := range m {
+			if v != nil {
+				values[k] = primitiveToCty(v)
+			}
+		}
+		return hclwrite.TokensForValue(cty.ObjectVal(values))
+	}
+
+	if target != "" {
+		if label, ok := g.labels[target][fmt.Sprintf("%v", value)]; ok {
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: target},
+				hcl.TraverseAttr{Name: label},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		}
+	}
+
+	return hclwrite.TokensForValue(primitiveToCty(value))
+}
+
+func primitiveToCty(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case int64:
+		return cty.NumberIntVal(v)
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	}
+	return cty.StringVal(fmt.Sprintf("%v", value))
+}
+
+func collectionItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+// isDefaultValue reports whether an attribute value equals its default or
+// the zero value of its type, so that it can be omitted.
+func isDefaultValue(attr *schema.Schema, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if attr.Default != nil {
+		return reflect.DeepEqual(attr.Default, value)
+	}
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.Len() == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return reflect.ValueOf(value).IsZero()
+}
@@ -0,0 +1,103 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigGenResources(t *testing.T) {
+	provider := Provider()
+	for _, r := range configGenResources {
+		t.Run(r.name, func(t *testing.T) {
+			res, ok := provider.ResourcesMap[r.name]
+			if !assert.True(t, ok, "resource type is not registered") {
+				return
+			}
+			for key, target := range r.references {
+				assert.Contains(t, res.Schema, key)
+				_, ok := getConfigGenResource(target)
+				assert.True(t, ok, "reference target %s of %s is not supported", target, key)
+			}
+		})
+	}
+}
+
+func TestSanitizeLabel(t *testing.T) {
+	for input, expected := range map[string]string{
+		"fra1":           "fra1",
+		"My Site":        "my_site",
+		"10.0.0.0/24":    "_10_0_0_0_24",
+		"eth0.100":       "eth0_100",
+		"--":             "",
+		"Core-Switch_01": "core_switch_01",
+	} {
+		assert.Equal(t, expected, sanitizeLabel(input), input)
+	}
+}
+
+func TestConfigGeneratorRender(t *testing.T) {
+	provider := Provider()
+
+	group := schema.TestResourceDataRaw(t, provider.ResourcesMap["netbox_tenant_group"].Schema, map[string]interface{}{
+		"name": "Customers",
+		"slug": "customers",
+	})
+	group.SetId("3")
+
+	tenant := schema.TestResourceDataRaw(t, provider.ResourcesMap["netbox_tenant"].Schema, map[string]interface{}{
+		"name":        "ACME ${corp}",
+		"slug":        "acme",
+		"group_id":    3,
+		"description": "",
+		"tags":        []interface{}{"managed"},
+	})
+	tenant.SetId("7")
+
+	other := schema.TestResourceDataRaw(t, provider.ResourcesMap["netbox_tenant"].Schema, map[string]interface{}{
+		"name":     "Other",
+		"slug":     "acme",
+		"group_id": 42,
+	})
+	other.SetId("8")
+
+	g := newConfigGenerator()
+	g.add("netbox_tenant_group", group)
+	g.add("netbox_tenant", tenant)
+	g.add("netbox_tenant", other)
+
+	assert.Equal(t, `import {
+  to = netbox_tenant_group.customers
+  id = "3"
+}
+
+resource "netbox_tenant_group" "customers" {
+  name = "Customers"
+  slug = "customers"
+}
+
+import {
+  to = netbox_tenant.acme
+  id = "7"
+}
+
+resource "netbox_tenant" "acme" {
+  name     = "ACME $${corp}"
+  group_id = netbox_tenant_group.customers.id
+  slug     = "acme"
+  tags     = ["managed"]
+}
+
+import {
+  to = netbox_tenant.acme_8
+  id = "8"
+}
+
+resource "netbox_tenant" "acme_8" {
+  name     = "Other"
+  group_id = 42
+  slug     = "acme"
+}
+`, string(g.render(provider)))
+}
@@ -0,0 +1,43 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// naturalKeyResolver resolves the natural key of an object to its numeric ID.
+type naturalKeyResolver func(api *providerState, key string) (int64, error)
+
+// naturalKeyImporter returns an importer that accepts either the numeric ID of
+// an object or its natural key, which is resolved by the given function.
+func naturalKeyImporter(resolve naturalKeyResolver) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			if _, err := strconv.ParseInt(d.Id(), 10, 64); err == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+
+			id, err := resolve(m.(*providerState), d.Id())
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(strconv.FormatInt(id, 10))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// getSingleImportResult returns the ID of the only object matching a natural
+// key and fails if there are none or several matches.
+func getSingleImportResult(objectType, key string, count int64, getID func() int64) (int64, error) {
+	switch {
+	case count == 0:
+		return 0, fmt.Errorf("no %s found matching %q", objectType, key)
+	case count > 1:
+		return 0, fmt.Errorf("found %d %s objects matching %q, use a more specific key or the numeric ID to import", count, objectType, key)
+	}
+	return getID(), nil
+}
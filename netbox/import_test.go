@@ -0,0 +1,21 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSingleImportResult(t *testing.T) {
+	getID := func() int64 { return 42 }
+
+	id, err := getSingleImportResult("site", "my-site", 1, getID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+
+	_, err = getSingleImportResult("site", "my-site", 0, getID)
+	assert.EqualError(t, err, `no site found matching "my-site"`)
+
+	_, err = getSingleImportResult("device", "my-device", 2, getID)
+	assert.EqualError(t, err, `found 2 device objects matching "my-device", use a more specific key or the numeric ID to import`)
+}
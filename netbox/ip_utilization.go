@@ -0,0 +1,266 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// ipInterval is an inclusive range of IP addresses of the same family.
+type ipInterval struct {
+	start netip.Addr
+	end   netip.Addr
+}
+
+func prefixToInterval(prefix netip.Prefix) ipInterval {
+	prefix = prefix.Masked()
+	end := addrToInt(prefix.Addr())
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	end.Add(end, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(hostBits)), big.NewInt(1)))
+	return ipInterval{start: prefix.Addr(), end: intToAddr(end, prefix.Addr().Is4())}
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func intToAddr(i *big.Int, is4 bool) netip.Addr {
+	size := 16
+	if is4 {
+		size = 4
+	}
+	addr, _ := netip.AddrFromSlice(i.FillBytes(make([]byte, size)))
+	return addr
+}
+
+func (i ipInterval) size() *big.Int {
+	size := new(big.Int).Sub(addrToInt(i.end), addrToInt(i.start))
+	return size.Add(size, big.NewInt(1))
+}
+
+func (i ipInterval) contains(addr netip.Addr) bool {
+	return i.start.Compare(addr) <= 0 && addr.Compare(i.end) <= 0
+}
+
+// ipSetSize returns the number of distinct addresses covered by the given
+// intervals, which may overlap.
+func ipSetSize(intervals []ipInterval) *big.Int {
+	sorted := make([]ipInterval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].start.Less(sorted[b].start)
+	})
+
+	total := new(big.Int)
+	var current *ipInterval
+	for _, i := range sorted {
+		if current != nil && i.start.BitLen() == current.end.BitLen() && (!current.end.Next().IsValid() || i.start.Compare(current.end.Next()) <= 0) {
+			if i.end.Compare(current.end) > 0 {
+				current.end = i.end
+			}
+			continue
+		}
+		if current != nil {
+			total.Add(total, current.size())
+		}
+		next := i
+		current = &next
+	}
+	if current != nil {
+		total.Add(total, current.size())
+	}
+	return total
+}
+
+// ipUtilization is the utilization of a prefix or IP range as calculated by
+// Netbox.
+type ipUtilization struct {
+	used  *big.Int
+	total *big.Int
+}
+
+func (u ipUtilization) percent() float64 {
+	if u.total.Sign() == 0 {
+		return 0
+	}
+	percent, _ := new(big.Float).Quo(
+		new(big.Float).Mul(new(big.Float).SetInt(u.used), big.NewFloat(100)),
+		new(big.Float).SetInt(u.total),
+	).Float64()
+	return math.Min(percent, 100)
+}
+
+// setUtilization sets the utilization attributes of a data source. Counts
+// that do not fit into an attribute, which is possible for IPv6, are capped.
+func (u ipUtilization) setUtilization(set func(key string, value interface{}) error) {
+	toInt := func(i *big.Int) int64 {
+		if !i.IsInt64() {
+			return math.MaxInt64
+		}
+		return i.Int64()
+	}
+	set("utilization_percent", u.percent())
+	set("used_ips", toInt(u.used))
+	set("total_ips", toInt(u.total))
+}
+
+// getPrefixUtilization calculates the utilization of a prefix the same way
+// Netbox does. The utilization of a container is the share of the prefix
+// covered by child prefixes, that of any other prefix the share of addresses
+// used by IP addresses and IP ranges marked as utilized. The network and
+// broadcast addresses of IPv4 prefixes that are not pools are not counted.
+func getPrefixUtilization(prefix netip.Prefix, isContainer, isPool, markUtilized bool, childPrefixes []netip.Prefix, childRanges []ipInterval, childAddresses []netip.Addr) ipUtilization {
+	interval := prefixToInterval(prefix)
+	total := interval.size()
+	if !isContainer && prefix.Addr().Is4() && prefix.Bits() < 31 && !isPool {
+		total.Sub(total, big.NewInt(2))
+	}
+
+	if markUtilized {
+		return ipUtilization{used: new(big.Int).Set(total), total: total}
+	}
+
+	var used []ipInterval
+	if isContainer {
+		for _, p := range childPrefixes {
+			used = append(used, prefixToInterval(p))
+		}
+	} else {
+		for _, r := range childRanges {
+			if interval.contains(r.start) && interval.contains(r.end) {
+				used = append(used, r)
+			}
+		}
+		for _, a := range childAddresses {
+			used = append(used, ipInterval{start: a, end: a})
+		}
+	}
+	return ipUtilization{used: ipSetSize(used), total: total}
+}
+
+// getIPRangeUtilization calculates the utilization of an IP range the same
+// way Netbox does, i.e. the share of addresses used by IP addresses.
+func getIPRangeUtilization(ipRange ipInterval, markUtilized bool, childAddresses []netip.Addr) ipUtilization {
+	total := ipRange.size()
+	if markUtilized {
+		return ipUtilization{used: new(big.Int).Set(total), total: total}
+	}
+
+	var used []ipInterval
+	for _, a := range childAddresses {
+		if ipRange.contains(a) {
+			used = append(used, ipInterval{start: a, end: a})
+		}
+	}
+	return ipUtilization{used: ipSetSize(used), total: total}
+}
+
+// vrfFilterValue returns the value of a vrf_id filter matching objects in
+// the given VRF, or in the global table if vrfID is 0.
+func vrfFilterValue(vrfID int64) string {
+	if vrfID == 0 {
+		return "null"
+	}
+	return strconv.FormatInt(vrfID, 10)
+}
+
+// listChildAddresses returns the addresses of all IP addresses within the
+// given prefix. If vrfID is nil, IP addresses of all VRFs are returned.
+func (s *providerState) listChildAddresses(ctx context.Context, prefix netip.Prefix, vrfID *int64) ([]netip.Addr, error) {
+	query := url.Values{"parent": {prefix.Masked().String()}, "brief": {"true"}}
+	if vrfID != nil {
+		query.Set("vrf_id", vrfFilterValue(*vrfID))
+	}
+	results, err := s.listRaw(ctx, "ipam/ip-addresses", query, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]netip.Addr, 0, len(results))
+	for _, r := range results {
+		address, err := netip.ParsePrefix(fmt.Sprint(r["address"]))
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address.Addr())
+	}
+	return addresses, nil
+}
+
+// listChildPrefixes returns all prefixes within the given prefix. If vrfID
+// is nil, prefixes of all VRFs are returned.
+func (s *providerState) listChildPrefixes(ctx context.Context, prefix netip.Prefix, vrfID *int64) ([]netip.Prefix, error) {
+	query := url.Values{"within": {prefix.Masked().String()}, "brief": {"true"}}
+	if vrfID != nil {
+		query.Set("vrf_id", vrfFilterValue(*vrfID))
+	}
+	results, err := s.listRaw(ctx, "ipam/prefixes", query, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(results))
+	for _, r := range results {
+		p, err := netip.ParsePrefix(fmt.Sprint(r["prefix"]))
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, p)
+	}
+	return prefixes, nil
+}
+
+// listUtilizedRanges returns all IP ranges in the given VRF that are marked
+// as utilized.
+func (s *providerState) listUtilizedRanges(ctx context.Context, vrfID int64) ([]ipInterval, error) {
+	query := url.Values{"vrf_id": {vrfFilterValue(vrfID)}, "mark_utilized": {"true"}}
+	results, err := s.listRaw(ctx, "ipam/ip-ranges", query, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges []ipInterval
+	for _, r := range results {
+		if markUtilized, _ := r["mark_utilized"].(bool); !markUtilized {
+			continue
+		}
+		i, err := parseIPRangeAddresses(fmt.Sprint(r["start_address"]), fmt.Sprint(r["end_address"]))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, i)
+	}
+	return ranges, nil
+}
+
+// parseIPRangeAddresses parses the start and end address of an IP range,
+// which are given in CIDR notation.
+func parseIPRangeAddresses(startAddress, endAddress string) (ipInterval, error) {
+	start, err := netip.ParsePrefix(startAddress)
+	if err != nil {
+		return ipInterval{}, err
+	}
+	end, err := netip.ParsePrefix(endAddress)
+	if err != nil {
+		return ipInterval{}, err
+	}
+	return ipInterval{start: start.Addr(), end: end.Addr()}, nil
+}
+
+// coveringPrefix returns the smallest prefix containing the whole interval.
+func (i ipInterval) coveringPrefix() netip.Prefix {
+	bits := i.start.BitLen()
+	for bits > 0 {
+		p := netip.PrefixFrom(i.start, bits).Masked()
+		if p.Contains(i.end) {
+			return p
+		}
+		bits--
+	}
+	return netip.PrefixFrom(i.start, 0).Masked()
+}
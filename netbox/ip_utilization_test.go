@@ -0,0 +1,181 @@
+package netbox
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPSetSize(t *testing.T) {
+	interval := func(start, end string) ipInterval {
+		return ipInterval{start: netip.MustParseAddr(start), end: netip.MustParseAddr(end)}
+	}
+
+	for _, tt := range []struct {
+		name      string
+		intervals []ipInterval
+		expected  int64
+	}{
+		{name: "empty", expected: 0},
+		{name: "single address", intervals: []ipInterval{interval("10.0.0.1", "10.0.0.1")}, expected: 1},
+		{
+			name:      "overlapping",
+			intervals: []ipInterval{interval("10.0.0.10", "10.0.0.20"), interval("10.0.0.1", "10.0.0.15"), interval("10.0.0.12", "10.0.0.12")},
+			expected:  20,
+		},
+		{
+			name:      "adjacent",
+			intervals: []ipInterval{interval("10.0.0.0", "10.0.0.9"), interval("10.0.0.10", "10.0.0.19")},
+			expected:  20,
+		},
+		{
+			name:      "disjoint",
+			intervals: []ipInterval{interval("10.0.0.0", "10.0.0.9"), interval("10.0.1.0", "10.0.1.9"), interval("2001:db8::", "2001:db8::1")},
+			expected:  22,
+		},
+		{
+			name:      "end of address space",
+			intervals: []ipInterval{interval("255.255.255.0", "255.255.255.255"), interval("255.255.255.255", "255.255.255.255")},
+			expected:  256,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, big.NewInt(tt.expected), ipSetSize(tt.intervals))
+		})
+	}
+}
+
+func TestGetPrefixUtilization(t *testing.T) {
+	addresses := func(addrs ...string) []netip.Addr {
+		var result []netip.Addr
+		for _, a := range addrs {
+			result = append(result, netip.MustParseAddr(a))
+		}
+		return result
+	}
+
+	for _, tt := range []struct {
+		name           string
+		prefix         string
+		isContainer    bool
+		isPool         bool
+		markUtilized   bool
+		childPrefixes  []netip.Prefix
+		childRanges    []ipInterval
+		childAddresses []netip.Addr
+		used           int64
+		total          int64
+		percent        float64
+	}{
+		{
+			name:           "network",
+			prefix:         "10.0.0.0/24",
+			childAddresses: addresses("10.0.0.1", "10.0.0.2", "10.0.0.2"),
+			used:           2,
+			total:          254,
+			percent:        float64(2) / 254 * 100,
+		},
+		{
+			name:           "pool",
+			prefix:         "10.0.0.0/24",
+			isPool:         true,
+			childAddresses: addresses("10.0.0.0", "10.0.0.1"),
+			used:           2,
+			total:          256,
+			percent:        float64(2) / 256 * 100,
+		},
+		{
+			name:           "point to point",
+			prefix:         "10.0.0.0/31",
+			childAddresses: addresses("10.0.0.0"),
+			used:           1,
+			total:          2,
+			percent:        50,
+		},
+		{
+			name:   "utilized ranges",
+			prefix: "10.0.0.0/24",
+			childRanges: []ipInterval{
+				{start: netip.MustParseAddr("10.0.0.100"), end: netip.MustParseAddr("10.0.0.199")},
+				{start: netip.MustParseAddr("10.0.1.100"), end: netip.MustParseAddr("10.0.1.199")},
+			},
+			childAddresses: addresses("10.0.0.1", "10.0.0.150"),
+			used:           101,
+			total:          254,
+			percent:        float64(101) / 254 * 100,
+		},
+		{
+			name:          "container",
+			prefix:        "10.0.0.0/16",
+			isContainer:   true,
+			childPrefixes: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/17"), netip.MustParsePrefix("10.0.0.0/24")},
+			used:          32768,
+			total:         65536,
+			percent:       50,
+		},
+		{
+			name:         "marked utilized",
+			prefix:       "10.0.0.0/24",
+			markUtilized: true,
+			used:         254,
+			total:        254,
+			percent:      100,
+		},
+		{
+			name:           "over utilized",
+			prefix:         "10.0.0.0/30",
+			childAddresses: addresses("10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"),
+			used:           4,
+			total:          2,
+			percent:        100,
+		},
+		{
+			name:           "ipv6",
+			prefix:         "2001:db8::/120",
+			childAddresses: addresses("2001:db8::1"),
+			used:           1,
+			total:          256,
+			percent:        float64(1) / 256 * 100,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			u := getPrefixUtilization(netip.MustParsePrefix(tt.prefix), tt.isContainer, tt.isPool, tt.markUtilized, tt.childPrefixes, tt.childRanges, tt.childAddresses)
+			assert.Equal(t, big.NewInt(tt.used), u.used)
+			assert.Equal(t, big.NewInt(tt.total), u.total)
+			assert.InDelta(t, tt.percent, u.percent(), 0.0001)
+		})
+	}
+}
+
+func TestGetIPRangeUtilization(t *testing.T) {
+	ipRange := ipInterval{start: netip.MustParseAddr("10.0.0.10"), end: netip.MustParseAddr("10.0.0.19")}
+
+	u := getIPRangeUtilization(ipRange, false, []netip.Addr{
+		netip.MustParseAddr("10.0.0.9"),
+		netip.MustParseAddr("10.0.0.10"),
+		netip.MustParseAddr("10.0.0.19"),
+		netip.MustParseAddr("10.0.0.20"),
+	})
+	assert.Equal(t, big.NewInt(2), u.used)
+	assert.Equal(t, big.NewInt(10), u.total)
+	assert.InDelta(t, 20, u.percent(), 0.0001)
+
+	u = getIPRangeUtilization(ipRange, true, nil)
+	assert.InDelta(t, 100, u.percent(), 0.0001)
+}
+
+func TestCoveringPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		start, end, expected string
+	}{
+		{"10.0.0.10", "10.0.0.19", "10.0.0.0/27"},
+		{"10.0.0.1", "10.0.0.1", "10.0.0.1/32"},
+		{"10.0.0.255", "10.0.1.0", "10.0.0.0/23"},
+		{"2001:db8::1", "2001:db8::ff", "2001:db8::/120"},
+	} {
+		i := ipInterval{start: netip.MustParseAddr(tt.start), end: netip.MustParseAddr(tt.end)}
+		assert.Equal(t, netip.MustParsePrefix(tt.expected), i.coveringPrefix())
+	}
+}
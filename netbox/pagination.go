@@ -0,0 +1,73 @@
+package netbox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const pageSizeKey = "page_size"
+
+var pageSizeSchema = &schema.Schema{
+	Type:             schema.TypeInt,
+	Optional:         true,
+	ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+	Description:      "The number of objects to request from the API per page. All pages are fetched until `limit` is reached or no more results are available. When unset, the page size of the Netbox server is used.",
+}
+
+// listPageFunc fetches a single page of a Netbox list endpoint using the given
+// limit and offset. It returns the results of the page and whether the API
+// reported a next page.
+type listPageFunc[T any] func(limit, offset *int64) ([]T, bool, error)
+
+// getListLimits returns the total limit and the page size configured on a
+// plural data source. A value of 0 means no limit or the server default,
+// respectively.
+func getListLimits(d *schema.ResourceData) (limit, pageSize int64) {
+	if limitValue, ok := d.GetOk("limit"); ok {
+		limit = int64(limitValue.(int))
+	}
+	if pageSizeValue, ok := d.GetOk(pageSizeKey); ok {
+		pageSize = int64(pageSizeValue.(int))
+	}
+	return limit, pageSize
+}
+
+// listAll pages through a Netbox list endpoint until limit results have been
+// collected or all results have been fetched. A limit of 0 fetches every
+// result. A pageSize of 0 lets the server decide how many results to return
+// per page.
+func listAll[T any](limit, pageSize int64, fetchPage listPageFunc[T]) ([]T, error) {
+	var results []T
+	var offset int64
+
+	for {
+		pageLimit := pageSize
+		if limit > 0 {
+			remaining := limit - int64(len(results))
+			if pageLimit == 0 || remaining < pageLimit {
+				pageLimit = remaining
+			}
+		}
+
+		var limitParam *int64
+		if pageLimit > 0 {
+			limitParam = int64ToPtr(pageLimit)
+		}
+
+		page, hasNext, err := fetchPage(limitParam, int64ToPtr(offset))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page...)
+
+		if !hasNext || len(page) == 0 || (limit > 0 && int64(len(results)) >= limit) {
+			break
+		}
+		offset += int64(len(page))
+	}
+
+	if limit > 0 && int64(len(results)) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
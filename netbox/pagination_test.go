@@ -0,0 +1,88 @@
+package netbox
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeListEndpoint simulates a Netbox list endpoint with total objects and a
+// server side page size of serverPageSize.
+func fakeListEndpoint(total, serverPageSize int64, calls *int) listPageFunc[int64] {
+	return func(limit, offset *int64) ([]int64, bool, error) {
+		*calls++
+		pageSize := serverPageSize
+		if limit != nil && *limit < pageSize {
+			pageSize = *limit
+		}
+		var page []int64
+		for i := *offset; i < total && int64(len(page)) < pageSize; i++ {
+			page = append(page, i)
+		}
+		return page, *offset+int64(len(page)) < total, nil
+	}
+}
+
+func TestListAll(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		total         int64
+		limit         int64
+		pageSize      int64
+		expectedLen   int
+		expectedCalls int
+	}{
+		{
+			name:          "AllResultsServerPageSize",
+			total:         120,
+			expectedLen:   120,
+			expectedCalls: 3,
+		},
+		{
+			name:          "AllResultsCustomPageSize",
+			total:         120,
+			pageSize:      30,
+			expectedLen:   120,
+			expectedCalls: 4,
+		},
+		{
+			name:          "LimitAcrossPages",
+			total:         120,
+			limit:         70,
+			expectedLen:   70,
+			expectedCalls: 2,
+		},
+		{
+			name:          "LimitAboveTotal",
+			total:         20,
+			limit:         70,
+			expectedLen:   20,
+			expectedCalls: 1,
+		},
+		{
+			name:          "NoResults",
+			total:         0,
+			expectedLen:   0,
+			expectedCalls: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			results, err := listAll(tt.limit, tt.pageSize, fakeListEndpoint(tt.total, 50, &calls))
+			assert.NoError(t, err)
+			assert.Len(t, results, tt.expectedLen)
+			assert.Equal(t, tt.expectedCalls, calls)
+			for i, r := range results {
+				assert.Equal(t, int64(i), r)
+			}
+		})
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	_, err := listAll(0, 0, func(limit, offset *int64) ([]int64, bool, error) {
+		return nil, false, errors.New("api error")
+	})
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/exp/slices"
 )
 
@@ -21,6 +22,9 @@ type providerState struct {
 
 	// concurrent access ok, only populated on provider start
 	tagCache map[string]*models.NestedTag
+
+	// populated on first use, guarded by its own mutex
+	customFieldCache customFieldCache
 }
 
 // This makes the description contain the default value, particularly useful for the docs
@@ -84,133 +88,167 @@ func init() {
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"netbox_available_ip_address":       resourceNetboxAvailableIPAddress(),
-			"netbox_virtual_machine":            resourceNetboxVirtualMachine(),
-			"netbox_cluster_type":               resourceNetboxClusterType(),
-			"netbox_cluster":                    resourceNetboxCluster(),
-			"netbox_contact":                    resourceNetboxContact(),
-			"netbox_contact_group":              resourceNetboxContactGroup(),
-			"netbox_contact_assignment":         resourceNetboxContactAssignment(),
-			"netbox_contact_role":               resourceNetboxContactRole(),
-			"netbox_device":                     resourceNetboxDevice(),
-			"netbox_device_interface":           resourceNetboxDeviceInterface(),
-			"netbox_device_type":                resourceNetboxDeviceType(),
-			"netbox_manufacturer":               resourceNetboxManufacturer(),
-			"netbox_tenant":                     resourceNetboxTenant(),
-			"netbox_tenant_group":               resourceNetboxTenantGroup(),
-			"netbox_vrf":                        resourceNetboxVrf(),
-			"netbox_ip_address":                 resourceNetboxIPAddress(),
-			"netbox_interface_template":         resourceNetboxInterfaceTemplate(),
-			"netbox_interface":                  resourceNetboxInterface(),
-			"netbox_service":                    resourceNetboxService(),
-			"netbox_platform":                   resourceNetboxPlatform(),
-			"netbox_prefix":                     resourceNetboxPrefix(),
-			"netbox_available_prefix":           resourceNetboxAvailablePrefix(),
-			"netbox_primary_ip":                 resourceNetboxPrimaryIP(),
-			"netbox_device_primary_ip":          resourceNetboxDevicePrimaryIP(),
-			"netbox_device_role":                resourceNetboxDeviceRole(),
-			"netbox_tag":                        resourceNetboxTag(),
-			"netbox_cluster_group":              resourceNetboxClusterGroup(),
-			"netbox_site":                       resourceNetboxSite(),
-			"netbox_vlan":                       resourceNetboxVlan(),
-			"netbox_vlan_group":                 resourceNetboxVlanGroup(),
-			"netbox_available_vlan":             resourceNetboxAvailableVLAN(),
-			"netbox_ipam_role":                  resourceNetboxIpamRole(),
-			"netbox_ip_range":                   resourceNetboxIPRange(),
-			"netbox_region":                     resourceNetboxRegion(),
-			"netbox_aggregate":                  resourceNetboxAggregate(),
-			"netbox_rir":                        resourceNetboxRir(),
-			"netbox_route_target":               resourceNetboxRouteTarget(),
-			"netbox_circuit":                    resourceNetboxCircuit(),
-			"netbox_circuit_type":               resourceNetboxCircuitType(),
-			"netbox_circuit_provider":           resourceNetboxCircuitProvider(),
-			"netbox_circuit_termination":        resourceNetboxCircuitTermination(),
-			"netbox_user":                       resourceNetboxUser(),
-			"netbox_group":                      resourceNetboxGroup(),
-			"netbox_permission":                 resourceNetboxPermission(),
-			"netbox_token":                      resourceNetboxToken(),
-			"netbox_custom_field":               resourceCustomField(),
-			"netbox_asn":                        resourceNetboxAsn(),
-			"netbox_location":                   resourceNetboxLocation(),
-			"netbox_site_group":                 resourceNetboxSiteGroup(),
-			"netbox_rack":                       resourceNetboxRack(),
-			"netbox_rack_type":                  resourceNetboxRackType(),
-			"netbox_rack_role":                  resourceNetboxRackRole(),
-			"netbox_rack_reservation":           resourceNetboxRackReservation(),
-			"netbox_cable":                      resourceNetboxCable(),
-			"netbox_device_console_port":        resourceNetboxDeviceConsolePort(),
-			"netbox_device_console_server_port": resourceNetboxDeviceConsoleServerPort(),
-			"netbox_device_power_port":          resourceNetboxDevicePowerPort(),
-			"netbox_device_power_outlet":        resourceNetboxDevicePowerOutlet(),
-			"netbox_device_front_port":          resourceNetboxDeviceFrontPort(),
-			"netbox_device_rear_port":           resourceNetboxDeviceRearPort(),
-			"netbox_device_module_bay":          resourceNetboxDeviceModuleBay(),
-			"netbox_device_bay":                 resourceNetboxDeviceBay(),
-			"netbox_device_bay_template":        resourceNetboxDeviceBayTemplate(),
-			"netbox_module":                     resourceNetboxModule(),
-			"netbox_module_type":                resourceNetboxModuleType(),
-			"netbox_power_feed":                 resourceNetboxPowerFeed(),
-			"netbox_power_panel":                resourceNetboxPowerPanel(),
-			"netbox_inventory_item_role":        resourceNetboxInventoryItemRole(),
-			"netbox_inventory_item":             resourceNetboxInventoryItem(),
-			"netbox_webhook":                    resourceNetboxWebhook(),
-			"netbox_custom_field_choice_set":    resourceNetboxCustomFieldChoiceSet(),
-			"netbox_virtual_chassis":            resourceNetboxVirtualChassis(),
-			"netbox_virtual_disk":               resourceNetboxVirtualDisks(),
-			"netbox_config_template":            resourceNetboxConfigTemplate(),
-			"netbox_event_rule":                 resourceNetboxEventRule(),
-			"netbox_vpn_tunnel_group":           resourceNetboxVpnTunnelGroup(),
-			"netbox_vpn_tunnel":                 resourceNetboxVpnTunnel(),
-			"netbox_vpn_tunnel_termination":     resourceNetboxVpnTunnelTermination(),
-			"netbox_config_context":             resourceNetboxConfigContext(),
-			"netbox_mac_address":                resourceNetboxMACAddress(),
+			"netbox_available_ip_address":         resourceNetboxAvailableIPAddress(),
+			"netbox_available_ip_addresses":       resourceNetboxAvailableIPAddresses(),
+			"netbox_virtual_machine":              resourceNetboxVirtualMachine(),
+			"netbox_cluster_type":                 resourceNetboxClusterType(),
+			"netbox_cluster":                      resourceNetboxCluster(),
+			"netbox_contact":                      resourceNetboxContact(),
+			"netbox_contact_group":                resourceNetboxContactGroup(),
+			"netbox_contact_assignment":           resourceNetboxContactAssignment(),
+			"netbox_contact_role":                 resourceNetboxContactRole(),
+			"netbox_device":                       resourceNetboxDevice(),
+			"netbox_device_interface":             resourceNetboxDeviceInterface(),
+			"netbox_device_type":                  resourceNetboxDeviceType(),
+			"netbox_device_type_from_library":     resourceNetboxDeviceTypeFromLibrary(),
+			"netbox_manufacturer":                 resourceNetboxManufacturer(),
+			"netbox_tenant":                       resourceNetboxTenant(),
+			"netbox_tenant_group":                 resourceNetboxTenantGroup(),
+			"netbox_vrf":                          resourceNetboxVrf(),
+			"netbox_ip_address":                   resourceNetboxIPAddress(),
+			"netbox_interface_template":           resourceNetboxInterfaceTemplate(),
+			"netbox_interface":                    resourceNetboxInterface(),
+			"netbox_service":                      resourceNetboxService(),
+			"netbox_platform":                     resourceNetboxPlatform(),
+			"netbox_prefix":                       resourceNetboxPrefix(),
+			"netbox_available_prefix":             resourceNetboxAvailablePrefix(),
+			"netbox_primary_ip":                   resourceNetboxPrimaryIP(),
+			"netbox_device_primary_ip":            resourceNetboxDevicePrimaryIP(),
+			"netbox_device_role":                  resourceNetboxDeviceRole(),
+			"netbox_tag":                          resourceNetboxTag(),
+			"netbox_cluster_group":                resourceNetboxClusterGroup(),
+			"netbox_site":                         resourceNetboxSite(),
+			"netbox_vlan":                         resourceNetboxVlan(),
+			"netbox_vlan_group":                   resourceNetboxVlanGroup(),
+			"netbox_available_vlan":               resourceNetboxAvailableVLAN(),
+			"netbox_ipam_role":                    resourceNetboxIpamRole(),
+			"netbox_ip_range":                     resourceNetboxIPRange(),
+			"netbox_region":                       resourceNetboxRegion(),
+			"netbox_aggregate":                    resourceNetboxAggregate(),
+			"netbox_rir":                          resourceNetboxRir(),
+			"netbox_route_target":                 resourceNetboxRouteTarget(),
+			"netbox_circuit":                      resourceNetboxCircuit(),
+			"netbox_circuit_type":                 resourceNetboxCircuitType(),
+			"netbox_circuit_provider":             resourceNetboxCircuitProvider(),
+			"netbox_circuit_termination":          resourceNetboxCircuitTermination(),
+			"netbox_user":                         resourceNetboxUser(),
+			"netbox_group":                        resourceNetboxGroup(),
+			"netbox_permission":                   resourceNetboxPermission(),
+			"netbox_token":                        resourceNetboxToken(),
+			"netbox_custom_field":                 resourceCustomField(),
+			"netbox_asn":                          resourceNetboxAsn(),
+			"netbox_asn_range":                    resourceNetboxAsnRange(),
+			"netbox_available_asn":                resourceNetboxAvailableAsn(),
+			"netbox_location":                     resourceNetboxLocation(),
+			"netbox_site_group":                   resourceNetboxSiteGroup(),
+			"netbox_rack":                         resourceNetboxRack(),
+			"netbox_rack_type":                    resourceNetboxRackType(),
+			"netbox_rack_role":                    resourceNetboxRackRole(),
+			"netbox_rack_reservation":             resourceNetboxRackReservation(),
+			"netbox_cable":                        resourceNetboxCable(),
+			"netbox_device_console_port":          resourceNetboxDeviceConsolePort(),
+			"netbox_device_console_server_port":   resourceNetboxDeviceConsoleServerPort(),
+			"netbox_device_power_port":            resourceNetboxDevicePowerPort(),
+			"netbox_device_power_outlet":          resourceNetboxDevicePowerOutlet(),
+			"netbox_device_front_port":            resourceNetboxDeviceFrontPort(),
+			"netbox_device_rear_port":             resourceNetboxDeviceRearPort(),
+			"netbox_device_module_bay":            resourceNetboxDeviceModuleBay(),
+			"netbox_device_bay":                   resourceNetboxDeviceBay(),
+			"netbox_device_bay_template":          resourceNetboxDeviceBayTemplate(),
+			"netbox_console_port_template":        resourceNetboxConsolePortTemplate(),
+			"netbox_console_server_port_template": resourceNetboxConsoleServerPortTemplate(),
+			"netbox_power_port_template":          resourceNetboxPowerPortTemplate(),
+			"netbox_power_outlet_template":        resourceNetboxPowerOutletTemplate(),
+			"netbox_front_port_template":          resourceNetboxFrontPortTemplate(),
+			"netbox_rear_port_template":           resourceNetboxRearPortTemplate(),
+			"netbox_module_bay_template":          resourceNetboxModuleBayTemplate(),
+			"netbox_inventory_item_template":      resourceNetboxInventoryItemTemplate(),
+			"netbox_module":                       resourceNetboxModule(),
+			"netbox_module_type":                  resourceNetboxModuleType(),
+			"netbox_power_feed":                   resourceNetboxPowerFeed(),
+			"netbox_power_panel":                  resourceNetboxPowerPanel(),
+			"netbox_inventory_item_role":          resourceNetboxInventoryItemRole(),
+			"netbox_inventory_item":               resourceNetboxInventoryItem(),
+			"netbox_webhook":                      resourceNetboxWebhook(),
+			"netbox_custom_field_choice_set":      resourceNetboxCustomFieldChoiceSet(),
+			"netbox_virtual_chassis":              resourceNetboxVirtualChassis(),
+			"netbox_virtual_disk":                 resourceNetboxVirtualDisks(),
+			"netbox_config_template":              resourceNetboxConfigTemplate(),
+			"netbox_event_rule":                   resourceNetboxEventRule(),
+			"netbox_vpn_tunnel_group":             resourceNetboxVpnTunnelGroup(),
+			"netbox_vpn_tunnel":                   resourceNetboxVpnTunnel(),
+			"netbox_vpn_tunnel_termination":       resourceNetboxVpnTunnelTermination(),
+			"netbox_ike_proposal":                 resourceNetboxIkeProposal(),
+			"netbox_ike_policy":                   resourceNetboxIkePolicy(),
+			"netbox_ipsec_proposal":               resourceNetboxIpsecProposal(),
+			"netbox_ipsec_policy":                 resourceNetboxIpsecPolicy(),
+			"netbox_ipsec_profile":                resourceNetboxIpsecProfile(),
+			"netbox_config_context":               resourceNetboxConfigContext(),
+			"netbox_mac_address":                  resourceNetboxMACAddress(),
+			"netbox_wireless_lan_group":           resourceNetboxWirelessLanGroup(),
+			"netbox_wireless_lan":                 resourceNetboxWirelessLan(),
+			"netbox_wireless_link":                resourceNetboxWirelessLink(),
+			"netbox_l2vpn":                        resourceNetboxL2vpn(),
+			"netbox_l2vpn_termination":            resourceNetboxL2vpnTermination(),
+			"netbox_fhrp_group":                   resourceNetboxFhrpGroup(),
+			"netbox_fhrp_group_assignment":        resourceNetboxFhrpGroupAssignment(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"netbox_asn":                dataSourceNetboxAsn(),
-			"netbox_asns":               dataSourceNetboxAsns(),
-			"netbox_available_prefix":   dataSourceNetboxAvailablePrefix(),
-			"netbox_cluster":            dataSourceNetboxCluster(),
-			"netbox_cluster_group":      dataSourceNetboxClusterGroup(),
-			"netbox_cluster_type":       dataSourceNetboxClusterType(),
-			"netbox_contact":            dataSourceNetboxContact(),
-			"netbox_contact_role":       dataSourceNetboxContactRole(),
-			"netbox_contact_group":      dataSourceNetboxContactGroup(),
-			"netbox_tenant":             dataSourceNetboxTenant(),
-			"netbox_tenants":            dataSourceNetboxTenants(),
-			"netbox_tenant_group":       dataSourceNetboxTenantGroup(),
-			"netbox_vrf":                dataSourceNetboxVrf(),
-			"netbox_vrfs":               dataSourceNetboxVrfs(),
-			"netbox_platform":           dataSourceNetboxPlatform(),
-			"netbox_prefix":             dataSourceNetboxPrefix(),
-			"netbox_prefixes":           dataSourceNetboxPrefixes(),
-			"netbox_devices":            dataSourceNetboxDevices(),
-			"netbox_device_role":        dataSourceNetboxDeviceRole(),
-			"netbox_device_type":        dataSourceNetboxDeviceType(),
-			"netbox_site":               dataSourceNetboxSite(),
-			"netbox_location":           dataSourceNetboxLocation(),
-			"netbox_locations":          dataSourceNetboxLocations(),
-			"netbox_tag":                dataSourceNetboxTag(),
-			"netbox_tags":               dataSourceNetboxTags(),
-			"netbox_virtual_machines":   dataSourceNetboxVirtualMachine(),
-			"netbox_interfaces":         dataSourceNetboxInterfaces(),
-			"netbox_device_interfaces":  dataSourceNetboxDeviceInterfaces(),
-			"netbox_device_power_ports": dataSourceNetboxDevicePowerPorts(),
-			"netbox_ipam_role":          dataSourceNetboxIPAMRole(),
-			"netbox_route_target":       dataSourceNetboxRouteTarget(),
-			"netbox_ip_address":         dataSourceNetboxIPAddress(),
-			"netbox_ip_addresses":       dataSourceNetboxIPAddresses(),
-			"netbox_ip_range":           dataSourceNetboxIPRange(),
-			"netbox_ip_ranges":          dataSourceNetboxIPRanges(),
-			"netbox_region":             dataSourceNetboxRegion(),
-			"netbox_rir":                dataSourceNetboxRir(),
-			"netbox_vlan":               dataSourceNetboxVlan(),
-			"netbox_vlans":              dataSourceNetboxVlans(),
-			"netbox_vlan_group":         dataSourceNetboxVlanGroup(),
-			"netbox_site_group":         dataSourceNetboxSiteGroup(),
-			"netbox_racks":              dataSourceNetboxRacks(),
-			"netbox_rack_role":          dataSourceNetboxRackRole(),
-			"netbox_config_context":     dataSourceNetboxConfigContext(),
-			"netbox_virtual_disk":       dataSourceNetboxVirtualDisk(),
+			"netbox_asn":                             dataSourceNetboxAsn(),
+			"netbox_asns":                            dataSourceNetboxAsns(),
+			"netbox_available_prefix":                dataSourceNetboxAvailablePrefix(),
+			"netbox_cluster":                         dataSourceNetboxCluster(),
+			"netbox_cluster_group":                   dataSourceNetboxClusterGroup(),
+			"netbox_cluster_type":                    dataSourceNetboxClusterType(),
+			"netbox_contact":                         dataSourceNetboxContact(),
+			"netbox_contact_role":                    dataSourceNetboxContactRole(),
+			"netbox_contact_group":                   dataSourceNetboxContactGroup(),
+			"netbox_tenant":                          dataSourceNetboxTenant(),
+			"netbox_tenants":                         dataSourceNetboxTenants(),
+			"netbox_tenant_group":                    dataSourceNetboxTenantGroup(),
+			"netbox_vrf":                             dataSourceNetboxVrf(),
+			"netbox_vrfs":                            dataSourceNetboxVrfs(),
+			"netbox_platform":                        dataSourceNetboxPlatform(),
+			"netbox_prefix":                          dataSourceNetboxPrefix(),
+			"netbox_prefixes":                        dataSourceNetboxPrefixes(),
+			"netbox_devices":                         dataSourceNetboxDevices(),
+			"netbox_device_role":                     dataSourceNetboxDeviceRole(),
+			"netbox_device_type":                     dataSourceNetboxDeviceType(),
+			"netbox_site":                            dataSourceNetboxSite(),
+			"netbox_location":                        dataSourceNetboxLocation(),
+			"netbox_locations":                       dataSourceNetboxLocations(),
+			"netbox_tag":                             dataSourceNetboxTag(),
+			"netbox_tags":                            dataSourceNetboxTags(),
+			"netbox_virtual_machines":                dataSourceNetboxVirtualMachine(),
+			"netbox_interfaces":                      dataSourceNetboxInterfaces(),
+			"netbox_device_interfaces":               dataSourceNetboxDeviceInterfaces(),
+			"netbox_device_power_ports":              dataSourceNetboxDevicePowerPorts(),
+			"netbox_ipam_role":                       dataSourceNetboxIPAMRole(),
+			"netbox_route_target":                    dataSourceNetboxRouteTarget(),
+			"netbox_ip_address":                      dataSourceNetboxIPAddress(),
+			"netbox_ip_addresses":                    dataSourceNetboxIPAddresses(),
+			"netbox_ip_range":                        dataSourceNetboxIPRange(),
+			"netbox_ip_ranges":                       dataSourceNetboxIPRanges(),
+			"netbox_region":                          dataSourceNetboxRegion(),
+			"netbox_rir":                             dataSourceNetboxRir(),
+			"netbox_vlan":                            dataSourceNetboxVlan(),
+			"netbox_vlans":                           dataSourceNetboxVlans(),
+			"netbox_vlan_group":                      dataSourceNetboxVlanGroup(),
+			"netbox_site_group":                      dataSourceNetboxSiteGroup(),
+			"netbox_racks":                           dataSourceNetboxRacks(),
+			"netbox_rack_role":                       dataSourceNetboxRackRole(),
+			"netbox_rack_units":                      dataSourceNetboxRackUnits(),
+			"netbox_config_context":                  dataSourceNetboxConfigContext(),
+			"netbox_virtual_disk":                    dataSourceNetboxVirtualDisk(),
+			"netbox_objects":                         dataSourceNetboxObjects(),
+			"netbox_device_rendered_config":          dataSourceNetboxDeviceRenderedConfig(),
+			"netbox_virtual_machine_rendered_config": dataSourceNetboxVirtualMachineRenderedConfig(),
+			"netbox_effective_config_context":        dataSourceNetboxEffectiveConfigContext(),
+			"netbox_cable_trace":                     dataSourceNetboxCableTrace(),
+			"netbox_wireless_lan_group":              dataSourceNetboxWirelessLanGroup(),
+			"netbox_wireless_lan":                    dataSourceNetboxWirelessLan(),
+			"netbox_wireless_link":                   dataSourceNetboxWirelessLink(),
+			"netbox_l2vpns":                          dataSourceNetboxL2vpns(),
 		},
 		Schema: map[string]*schema.Schema{
 			"server_url": {
@@ -255,6 +293,41 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_REQUEST_TIMEOUT", 10),
 				Description: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
 			},
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_MAX_RETRIES", 3),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum number of times a request to Netbox is retried after a transient error (HTTP status 429, 502, 503 or 504, or a connection error). `GET`, `PUT`, `PATCH` and `DELETE` requests are retried on all transient errors, `POST` requests only when the connection was refused. Set to `0` to disable retries. Can be set via the `NETBOX_MAX_RETRIES` environment variable. Defaults to `3`.",
+			},
+			"retry_wait_min": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_RETRY_WAIT_MIN", 1),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Minimum time in seconds to wait before retrying a request. The wait time doubles with every retry. Can be set via the `NETBOX_RETRY_WAIT_MIN` environment variable. Defaults to `1`.",
+			},
+			"retry_wait_max": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_RETRY_WAIT_MAX", 30),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum time in seconds to wait before retrying a request. This also caps the wait time requested by Netbox via the `Retry-After` header. Can be set via the `NETBOX_RETRY_WAIT_MAX` environment variable. Defaults to `30`.",
+			},
+			"max_requests_per_second": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_MAX_REQUESTS_PER_SECOND", 0.0),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+				Description:      "Maximum number of requests per second sent to Netbox. Requests exceeding this rate are queued instead of failing. Set to `0` for no limit. Can be set via the `NETBOX_MAX_REQUESTS_PER_SECOND` environment variable. Defaults to `0`.",
+			},
+			"max_concurrent_requests": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Maximum number of requests sent to Netbox at the same time. Further requests are queued until a running request finishes. Set to `0` for no limit. Can be set via the `NETBOX_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0`.",
+			},
 			"default_tags": {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
@@ -274,7 +347,7 @@ func Provider() *schema.Provider {
 	}
 
 	// all resources that have tags get a custom diff function
-	for _, def := range provider.ResourcesMap {
+	for name, def := range provider.ResourcesMap {
 		if _, ok := def.Schema[tagsKey]; ok {
 			def.Schema[tagsAllKey] = tagsAllSchema // add computed key for all tags
 			if existingDiff := def.CustomizeDiff; existingDiff != nil {
@@ -283,6 +356,15 @@ func Provider() *schema.Provider {
 				def.CustomizeDiff = tagsCustomDiff
 			}
 		}
+
+		// resources with custom fields validate them against their definitions
+		if objectType, ok := customFieldObjectTypes[name]; ok {
+			if existingDiff := def.CustomizeDiff; existingDiff != nil {
+				def.CustomizeDiff = customdiff.Sequence(existingDiff, customFieldsCustomDiff(objectType))
+			} else {
+				def.CustomizeDiff = customFieldsCustomDiff(objectType)
+			}
+		}
 	}
 
 	return provider
@@ -298,6 +380,11 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		RequestTimeout:              data.Get("request_timeout").(int),
 		StripTrailingSlashesFromURL: data.Get("strip_trailing_slashes_from_url").(bool),
 		CACertFile:                  data.Get("ca_cert_file").(string),
+		MaxRetries:                  data.Get("max_retries").(int),
+		RetryWaitMin:                data.Get("retry_wait_min").(int),
+		RetryWaitMax:                data.Get("retry_wait_max").(int),
+		MaxRequestsPerSecond:        data.Get("max_requests_per_second").(float64),
+		MaxConcurrentRequests:       data.Get("max_concurrent_requests").(int),
 	}
 
 	serverURL := data.Get("server_url").(string)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -30,6 +31,24 @@ func testAccGetTestName(testSlug string) string {
 	return strings.Join([]string{testPrefix, testSlug, randomString}, "-")
 }
 
+// testAccImportStateIDFromAttributes returns an ImportStateIdFunc building the
+// import ID from the given format and resource attributes, each given as
+// <resource address>.<attribute>.
+func testAccImportStateIDFromAttributes(format string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		values := make([]interface{}, 0, len(attributes))
+		for _, attribute := range attributes {
+			i := strings.LastIndex(attribute, ".")
+			rs, ok := s.RootModule().Resources[attribute[:i]]
+			if !ok {
+				return "", fmt.Errorf("resource %s not found in state", attribute[:i])
+			}
+			values = append(values, rs.Primary.Attributes[attribute[i+1:]])
+		}
+		return fmt.Sprintf(format, values...), nil
+	}
+}
+
 func testAccGetTestToken() string {
 	randomToken := acctest.RandStringFromCharSet(40, "0123456789")
 	return randomToken
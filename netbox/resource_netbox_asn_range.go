@@ -0,0 +1,194 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// asnRange is an ASN range as returned by the API, which is not covered by
+// go-netbox.
+type asnRange struct {
+	ID          int64                `json:"id"`
+	Name        string               `json:"name"`
+	Slug        string               `json:"slug"`
+	Rir         *models.NestedRIR    `json:"rir"`
+	Start       int64                `json:"start"`
+	End         int64                `json:"end"`
+	Tenant      *models.NestedTenant `json:"tenant"`
+	Description string               `json:"description"`
+	Tags        []*models.NestedTag  `json:"tags"`
+}
+
+type writableASNRange struct {
+	Name        string              `json:"name"`
+	Slug        string              `json:"slug"`
+	Rir         int64               `json:"rir"`
+	Start       int64               `json:"start"`
+	End         int64               `json:"end"`
+	Tenant      *int64              `json:"tenant"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+func resourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxAsnRangeCreate,
+		ReadContext:   resourceNetboxAsnRangeRead,
+		UpdateContext: resourceNetboxAsnRangeUpdate,
+		DeleteContext: resourceNetboxAsnRangeDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning. Each range must be assigned to a RIR.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"start": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+				Description:  "The first AS number of the range.",
+			},
+			"end": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+				Description:  "The last AS number of the range.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableASNRange(api *providerState, d *schema.ResourceData) (*writableASNRange, error) {
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := writableASNRange{
+		Name:        name,
+		Slug:        slug,
+		Rir:         int64(d.Get("rir_id").(int)),
+		Start:       int64(d.Get("start").(int)),
+		End:         int64(d.Get("end").(int)),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Description: d.Get("description").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func resourceNetboxAsnRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableASNRange(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result asnRange
+	if err := api.postRaw(ctx, "ipam/asn-ranges", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxAsnRangeRead(ctx, d, m)
+}
+
+func resourceNetboxAsnRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var result asnRange
+	if err := api.getRaw(ctx, fmt.Sprintf("ipam/asn-ranges/%s", d.Id()), nil, &result); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	if result.Rir != nil {
+		d.Set("rir_id", result.Rir.ID)
+	}
+	d.Set("start", result.Start)
+	d.Set("end", result.End)
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", result.Description)
+	api.readTags(d, result.Tags)
+
+	return nil
+}
+
+func resourceNetboxAsnRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableASNRange(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result asnRange
+	if err := api.putRaw(ctx, fmt.Sprintf("ipam/asn-ranges/%s", d.Id()), data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxAsnRangeRead(ctx, d, m)
+}
+
+func resourceNetboxAsnRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("ipam/asn-ranges/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
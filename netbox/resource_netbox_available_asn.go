@@ -0,0 +1,163 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxAvailableAsn() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxAvailableAsnCreate,
+		ReadContext:   resourceNetboxAvailableAsnRead,
+		UpdateContext: resourceNetboxAvailableAsnUpdate,
+		DeleteContext: resourceNetboxAvailableAsnDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource will retrieve the next available AS number from a given ASN range (specified by ID).`,
+
+		Schema: map[string]*schema.Schema{
+			"asn_range_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxAvailableAsnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	rangeID := d.Get("asn_range_id").(int)
+
+	// Netbox allocates the ASN and creates it in a single transaction.
+	var result []*models.ASN
+	if err := api.postRaw(ctx, fmt.Sprintf("ipam/asn-ranges/%d/available-asns", rangeID), []map[string]interface{}{{}}, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(result) == 0 {
+		return diag.Errorf("no available AS numbers in ASN range %d", rangeID)
+	}
+
+	// Since we generated the asn, set that now
+	d.SetId(strconv.FormatInt(result[0].ID, 10))
+	d.Set("asn", result[0].Asn)
+	if result[0].Rir != nil {
+		d.Set("rir_id", result[0].Rir.ID)
+	}
+
+	return resourceNetboxAvailableAsnUpdate(ctx, d, m)
+}
+
+func resourceNetboxAvailableAsnRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamAsnsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamAsnsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	asn := res.GetPayload()
+	d.Set("asn", asn.Asn)
+	if asn.Rir != nil {
+		d.Set("rir_id", asn.Rir.ID)
+	}
+	if asn.Tenant != nil {
+		d.Set("tenant_id", asn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", asn.Description)
+	d.Set("comments", asn.Comments)
+	api.readTags(d, asn.Tags)
+
+	return nil
+}
+
+func resourceNetboxAvailableAsnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableASN{}
+
+	asn := int64(d.Get("asn").(int))
+	data.Asn = &asn
+
+	rir := int64(d.Get("rir_id").(int))
+	data.Rir = &rir
+
+	data.Tenant = getOptionalInt(d, "tenant_id")
+	data.Description = d.Get("description").(string)
+	data.Comments = d.Get("comments").(string)
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := ipam.NewIpamAsnsUpdateParams().WithID(id).WithData(&data)
+
+	_, err = api.Ipam.IpamAsnsUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxAvailableAsnRead(ctx, d, m)
+}
+
+func resourceNetboxAvailableAsnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamAsnsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamAsnsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package netbox
 
 import (
+	"context"
 	"fmt"
+	"net/netip"
+	"net/url"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
@@ -17,6 +20,8 @@ func resourceNetboxAvailableIPAddress() *schema.Resource {
 		Update: resourceNetboxAvailableIPAddressUpdate,
 		Delete: resourceNetboxAvailableIPAddressDelete,
 
+		CustomizeDiff: allocationKeyCustomizeDiff,
+
 		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://netbox.readthedocs.io/en/stable/models/ipam/ipaddress/):
 
 > An IP address comprises a single host address (either IPv4 or IPv6) and its subnet mask. Its mask should match exactly how the IP address is configured on an interface in the real world.
@@ -50,6 +55,7 @@ This resource will retrieve the next available IP address from a given prefix or
 				Type:         schema.TypeInt,
 				Optional:     true,
 				RequiredWith: []string{"object_type"},
+				Description:  "The ID of the object the IP address is assigned to. With `object_type` set to `ipam.fhrpgroup`, this is the ID of an FHRP group, making the IP address its virtual IP.",
 			},
 			"object_type": {
 				Type:         schema.TypeString,
@@ -98,6 +104,8 @@ This resource will retrieve the next available IP address from a given prefix or
 				ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressRoleOptions, false),
 				Description:  buildValidValueDescription(resourceNetboxIPAddressRoleOptions),
 			},
+			allocationKeyKey:      allocationKeySchema,
+			allocationKeyFieldKey: allocationKeyFieldSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -107,6 +115,21 @@ This resource will retrieve the next available IP address from a given prefix or
 
 func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*providerState)
+
+	ipAddress, err := findAllocatedIPAddress(api, d)
+	if err != nil {
+		return err
+	}
+	if ipAddress != nil {
+		id, err := getRawID(ipAddress)
+		if err != nil {
+			return err
+		}
+		d.SetId(strconv.FormatInt(id, 10))
+		d.Set("ip_address", fmt.Sprint(ipAddress["address"]))
+		return resourceNetboxAvailableIPAddressUpdate(d, m)
+	}
+
 	prefixID := int64(d.Get("prefix_id").(int))
 	vrfID := int64(int64(d.Get("vrf_id").(int)))
 	rangeID := int64(d.Get("ip_range_id").(int))
@@ -204,6 +227,7 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 	d.Set("description", ipAddress.Description)
 	d.Set("status", ipAddress.Status.Value)
 	api.readTags(d, ipAddress.Tags)
+	stripAllocationKey(d)
 	return nil
 }
 
@@ -254,6 +278,9 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 	if err != nil {
 		return err
 	}
+	if err := api.setAllocationKey(d, &data.Description, &data.Tags, &data.CustomFields); err != nil {
+		return err
+	}
 
 	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
 
@@ -282,3 +309,37 @@ func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{
 	}
 	return nil
 }
+
+// findAllocatedIPAddress looks up an IP address within the prefix or IP
+// range of the resource that carries its allocation key.
+func findAllocatedIPAddress(api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	if key, _ := getAllocationKey(d); key == "" {
+		return nil, nil
+	}
+
+	query := url.Values{"vrf_id": {vrfFilterValue(int64(d.Get("vrf_id").(int)))}}
+	var filter func(map[string]interface{}) bool
+	if prefixID, ok := d.GetOk("prefix_id"); ok {
+		res, err := api.Ipam.IpamPrefixesRead(ipam.NewIpamPrefixesReadParams().WithID(int64(prefixID.(int))), nil)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("parent", *res.GetPayload().Prefix)
+	} else {
+		res, err := api.Ipam.IpamIPRangesRead(ipam.NewIpamIPRangesReadParams().WithID(int64(d.Get("ip_range_id").(int))), nil)
+		if err != nil {
+			return nil, err
+		}
+		ipRange, err := parseIPRangeAddresses(*res.GetPayload().StartAddress, *res.GetPayload().EndAddress)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("parent", ipRange.coveringPrefix().String())
+		filter = func(ipAddress map[string]interface{}) bool {
+			address, err := netip.ParsePrefix(fmt.Sprint(ipAddress["address"]))
+			return err == nil && ipRange.contains(address.Addr())
+		}
+	}
+
+	return api.findAllocatedObject(context.Background(), d, "ipam/ip-addresses", query, filter)
+}
@@ -284,6 +284,41 @@ resource "netbox_available_ip_address" "test" {
 	})
 }
 
+func TestAccNetboxAvailableIPAddress_allocationKey(t *testing.T) {
+	testName := testAccGetTestName("available_ip_key")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "1.1.11.0/24"
+  status = "active"
+}
+resource "netbox_ip_address" "existing" {
+  ip_address  = "1.1.11.10/24"
+  status      = "active"
+  description = "%[1]s"
+}
+resource "netbox_available_ip_address" "test" {
+  prefix_id      = netbox_prefix.test.id
+  allocation_key = "%[1]s"
+  dns_name       = "adopted.example.com"
+
+  depends_on = [netbox_ip_address.existing]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_available_ip_address.test", "id", "netbox_ip_address.existing", "id"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address", "1.1.11.10/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "dns_name", "adopted.example.com"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "description", ""),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_ip_address", &resource.Sweeper{
 		Name:         "netbox_available_ip_address",
@@ -0,0 +1,533 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxAvailableIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxAvailableIPAddressesCreate,
+		Read:          resourceNetboxAvailableIPAddressesRead,
+		Update:        resourceNetboxAvailableIPAddressesUpdate,
+		Delete:        resourceNetboxAvailableIPAddressesDelete,
+		CustomizeDiff: resourceNetboxAvailableIPAddressesCustomizeDiff,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource allocates multiple available IP addresses from a given prefix or IP range (specified by ID) in a single request and manages them as one unit, e.g. for the nodes of a cluster.
+
+By default, the first available addresses are allocated, which are not necessarily contiguous. With ` + "`contiguous`" + ` set, the first run of consecutive available addresses is looked up and created in a single request instead. Only as many available addresses as the ` + "`MAX_PAGE_SIZE`" + ` setting of Netbox allows in one response are searched. As the lookup and the creation are separate requests, another client may allocate one of the addresses in between; the created addresses are checked for duplicates afterwards and released again if any are found, in which case the apply has to be retried.
+
+The DNS name and the per-address attributes may contain the placeholders ` + "`{index}`" + ` and ` + "`{number}`" + `, which are replaced by the zero-based and the one-based position of the address, respectively.`,
+
+		Schema: map[string]*schema.Schema{
+			"prefix_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+			},
+			"ip_range_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+			},
+			"address_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of IP addresses to allocate.",
+			},
+			"contiguous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the allocated IP addresses have to be consecutive.",
+			},
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIPAddressStatusOptions),
+				Default:      "active",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressRoleOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIPAddressRoleOptions),
+			},
+			"dns_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template for the DNS names of the IP addresses, e.g. `node-{number}.example.com`.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey: tagsSchema,
+			"address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Attributes of single IP addresses, by position. Attributes set here take precedence over those set on the resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressStatusOptions, false),
+							Description:  buildValidValueDescription(resourceNetboxIPAddressStatusOptions),
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressRoleOptions, false),
+							Description:  buildValidValueDescription(resourceNetboxIPAddressRoleOptions),
+						},
+					},
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The allocated IP addresses, in ascending order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// availableIPAddressAttributes are the attributes that can differ between
+// the IP addresses managed by a netbox_available_ip_addresses resource.
+type availableIPAddressAttributes struct {
+	dnsName     string
+	description string
+	status      string
+	role        string
+}
+
+// getAvailableIPAddressAttributes returns the configured attributes of the
+// IP address at the given position. d is either a *schema.ResourceData or a
+// *schema.ResourceDiff.
+func getAvailableIPAddressAttributes(d interface{ Get(string) interface{} }, index int) availableIPAddressAttributes {
+	attributes := availableIPAddressAttributes{
+		dnsName:     d.Get("dns_name").(string),
+		description: d.Get("description").(string),
+		status:      d.Get("status").(string),
+		role:        d.Get("role").(string),
+	}
+	if addresses := d.Get("address").([]interface{}); index < len(addresses) && addresses[index] != nil {
+		address := addresses[index].(map[string]interface{})
+		for key, value := range map[string]*string{
+			"dns_name":    &attributes.dnsName,
+			"description": &attributes.description,
+			"status":      &attributes.status,
+			"role":        &attributes.role,
+		} {
+			if v := address[key].(string); v != "" {
+				*value = v
+			}
+		}
+	}
+
+	replacer := strings.NewReplacer("{index}", strconv.Itoa(index), "{number}", strconv.Itoa(index+1))
+	attributes.dnsName = replacer.Replace(attributes.dnsName)
+	attributes.description = replacer.Replace(attributes.description)
+	return attributes
+}
+
+// findContiguousAddresses returns the first count consecutive addresses of
+// the given available addresses, which are in CIDR notation and sorted.
+func findContiguousAddresses(available []string, count int) ([]string, error) {
+	var run []string
+	var last netip.Addr
+	for _, a := range available {
+		prefix, err := netip.ParsePrefix(a)
+		if err != nil {
+			return nil, err
+		}
+		if len(run) > 0 && last.Next() != prefix.Addr() {
+			run = nil
+		}
+		run = append(run, a)
+		last = prefix.Addr()
+		if len(run) == count {
+			return run, nil
+		}
+	}
+	return nil, fmt.Errorf("no %d contiguous available IP addresses found", count)
+}
+
+// availableIPAddressesPageSize is the number of available addresses that is
+// requested first when looking for contiguous addresses.
+const availableIPAddressesPageSize = 256
+
+// listAvailableIPAddresses returns the first limit available addresses of
+// the given prefix or, if prefixID is 0, IP range.
+func listAvailableIPAddresses(api *providerState, prefixID, rangeID int64, limit int) ([]string, error) {
+	var available []*models.AvailableIP
+	query := url.Values{"limit": {strconv.Itoa(limit)}}
+	if prefixID != 0 {
+		res, err := api.Ipam.IpamPrefixesAvailableIpsList(ipam.NewIpamPrefixesAvailableIpsListParams().WithID(prefixID), nil, withQuery(query))
+		if err != nil {
+			return nil, err
+		}
+		available = res.Payload
+	} else {
+		res, err := api.Ipam.IpamIPRangesAvailableIpsList(ipam.NewIpamIPRangesAvailableIpsListParams().WithID(rangeID), nil, withQuery(query))
+		if err != nil {
+			return nil, err
+		}
+		available = res.Payload
+	}
+
+	addresses := make([]string, 0, len(available))
+	for _, a := range available {
+		addresses = append(addresses, a.Address)
+	}
+	return addresses, nil
+}
+
+// findAvailableContiguousAddresses returns the first count consecutive
+// available addresses of the given prefix or IP range. The available-ips
+// endpoint does not support offsets, so the addresses are requested in pages
+// of growing size until a run is found or no more addresses are returned.
+// The search is therefore limited by the MAX_PAGE_SIZE setting of Netbox.
+func findAvailableContiguousAddresses(api *providerState, prefixID, rangeID int64, count int) ([]string, error) {
+	limit := max(count, availableIPAddressesPageSize)
+	for {
+		available, err := listAvailableIPAddresses(api, prefixID, rangeID, limit)
+		if err != nil {
+			return nil, err
+		}
+		addresses, err := findContiguousAddresses(available, count)
+		if err == nil || len(available) < limit {
+			return addresses, err
+		}
+		limit *= 2
+	}
+}
+
+// verifyAllocatedIPAddresses checks that no other IP address with the same
+// address was created in the VRF between looking up the available addresses
+// and creating them. If one was, the created IP addresses are deleted again.
+func verifyAllocatedIPAddresses(ctx context.Context, api *providerState, vrfID int64, created []*models.IPAddress) error {
+	query := url.Values{"vrf_id": {vrfFilterValue(vrfID)}, "brief": {"true"}}
+	for _, ipAddress := range created {
+		query.Add("address", *ipAddress.Address)
+	}
+	existing, err := api.listRaw(ctx, "ipam/ip-addresses", query, 0, 0)
+	if err != nil {
+		return err
+	}
+	if len(existing) == len(created) {
+		return nil
+	}
+
+	for _, ipAddress := range created {
+		if err := api.deleteRaw(ctx, fmt.Sprintf("ipam/ip-addresses/%d", ipAddress.ID)); err != nil && !isRawNotFound(err) {
+			return fmt.Errorf("IP addresses were allocated concurrently by another client and could not be released: %w", err)
+		}
+	}
+	return fmt.Errorf("IP addresses were allocated concurrently by another client, please retry")
+}
+
+func resourceNetboxAvailableIPAddressesCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	prefixID := int64(d.Get("prefix_id").(int))
+	rangeID := int64(d.Get("ip_range_id").(int))
+	vrfID := int64(d.Get("vrf_id").(int))
+	count := d.Get("address_count").(int)
+
+	var created []*models.IPAddress
+	if d.Get("contiguous").(bool) {
+		// The available-ips endpoint always returns the first available
+		// addresses, so look up a run of addresses and create those at once.
+		// Netbox creates all objects of a bulk request in one transaction.
+		addresses, err := findAvailableContiguousAddresses(api, prefixID, rangeID, count)
+		if err != nil {
+			return err
+		}
+
+		data := make([]*models.WritableIPAddress, 0, count)
+		for i, address := range addresses {
+			ipAddress, err := getAvailableIPAddressesData(d, api, i)
+			if err != nil {
+				return err
+			}
+			ipAddress.Address = strToPtr(address)
+			data = append(data, ipAddress)
+		}
+
+		if err := api.postRaw(context.Background(), "ipam/ip-addresses", data, &created); err != nil {
+			return err
+		}
+		if err := verifyAllocatedIPAddresses(context.Background(), api, vrfID, created); err != nil {
+			return err
+		}
+	} else {
+		data := make([]*models.AvailableIP, 0, count)
+		for i := 0; i < count; i++ {
+			data = append(data, &models.AvailableIP{Vrf: &models.NestedVRF{ID: vrfID}})
+		}
+
+		if prefixID != 0 {
+			params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixID).WithData(data)
+			res, err := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
+			if err != nil {
+				return err
+			}
+			created = res.Payload
+		} else {
+			params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(rangeID).WithData(data)
+			res, err := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
+			if err != nil {
+				return err
+			}
+			created = res.Payload
+		}
+	}
+
+	if len(created) != count {
+		return fmt.Errorf("expected %d IP addresses to be allocated, got %d", count, len(created))
+	}
+
+	ipAddresses := make([]map[string]interface{}, 0, len(created))
+	for _, ipAddress := range created {
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"id":         ipAddress.ID,
+			"ip_address": *ipAddress.Address,
+		})
+	}
+	d.SetId(strconv.FormatInt(created[0].ID, 10))
+	d.Set("ip_addresses", ipAddresses)
+
+	return resourceNetboxAvailableIPAddressesUpdate(d, m)
+}
+
+// getAvailableIPAddressesIDs returns the IDs of the IP addresses managed by
+// the resource.
+func getAvailableIPAddressesIDs(d *schema.ResourceData) []int64 {
+	var ids []int64
+	for _, ipAddress := range d.Get("ip_addresses").([]interface{}) {
+		ids = append(ids, int64(ipAddress.(map[string]interface{})["id"].(int)))
+	}
+	return ids
+}
+
+// getAvailableIPAddressesData returns the data to write to the IP address at
+// the given position, except for the address itself.
+func getAvailableIPAddressesData(d *schema.ResourceData, api *providerState, index int) (*models.WritableIPAddress, error) {
+	attributes := getAvailableIPAddressAttributes(d, index)
+	data := models.WritableIPAddress{
+		Status:      attributes.status,
+		Role:        attributes.role,
+		DNSName:     attributes.dnsName,
+		Description: attributes.description,
+		Vrf:         getOptionalInt(d, "vrf_id"),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func resourceNetboxAvailableIPAddressesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var ipAddresses []map[string]interface{}
+	var first *models.IPAddress
+	for _, id := range getAvailableIPAddressesIDs(d) {
+		res, err := api.Ipam.IpamIPAddressesRead(ipam.NewIpamIPAddressesReadParams().WithID(id), nil)
+		if err != nil {
+			if errresp, ok := err.(*ipam.IpamIPAddressesReadDefault); ok && errresp.Code() == 404 {
+				// IP addresses deleted out of band are dropped, which forces
+				// the replacement of the resource through address_count.
+				continue
+			}
+			return err
+		}
+
+		ipAddress := res.GetPayload()
+		if first == nil {
+			first = ipAddress
+		}
+		var status, role string
+		if ipAddress.Status != nil && ipAddress.Status.Value != nil {
+			status = *ipAddress.Status.Value
+		}
+		if ipAddress.Role != nil && ipAddress.Role.Value != nil {
+			role = *ipAddress.Role.Value
+		}
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"id":          ipAddress.ID,
+			"ip_address":  *ipAddress.Address,
+			"dns_name":    ipAddress.DNSName,
+			"description": ipAddress.Description,
+			"status":      status,
+			"role":        role,
+		})
+	}
+
+	if first == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ip_addresses", ipAddresses)
+	d.Set("address_count", len(ipAddresses))
+
+	if first.Vrf != nil {
+		d.Set("vrf_id", first.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
+	}
+
+	if first.Tenant != nil {
+		d.Set("tenant_id", first.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, first.Tags)
+	return nil
+}
+
+func resourceNetboxAvailableIPAddressesUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	for i, ipAddress := range d.Get("ip_addresses").([]interface{}) {
+		ipAddress := ipAddress.(map[string]interface{})
+		id := int64(ipAddress["id"].(int))
+
+		data, err := getAvailableIPAddressesData(d, api, i)
+		if err != nil {
+			return err
+		}
+		data.Address = strToPtr(ipAddress["ip_address"].(string))
+
+		params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(data)
+		if _, err := api.Ipam.IpamIPAddressesUpdate(params, nil); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxAvailableIPAddressesRead(d, m)
+}
+
+func resourceNetboxAvailableIPAddressesDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	for _, id := range getAvailableIPAddressesIDs(d) {
+		params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
+		if _, err := api.Ipam.IpamIPAddressesDelete(params, nil); err != nil {
+			if errresp, ok := err.(*ipam.IpamIPAddressesDeleteDefault); ok && errresp.Code() == 404 {
+				continue
+			}
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceNetboxAvailableIPAddressesCustomizeDiff validates the number of
+// address blocks and plans the attributes of every IP address, so that an
+// update is planned if any of them differs from its configuration, e.g.
+// after a change in Netbox.
+func resourceNetboxAvailableIPAddressesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	count := d.Get("address_count").(int)
+	if addresses := d.Get("address").([]interface{}); len(addresses) > count {
+		return fmt.Errorf("%d address blocks given, but only %d IP addresses are allocated", len(addresses), count)
+	}
+
+	if d.Id() == "" || d.HasChanges("prefix_id", "ip_range_id", "address_count", "contiguous", "vrf_id") {
+		return nil
+	}
+
+	ipAddresses := d.Get("ip_addresses").([]interface{})
+	planned := make([]interface{}, 0, len(ipAddresses))
+	changed := false
+	for i, ipAddress := range ipAddresses {
+		ipAddress := ipAddress.(map[string]interface{})
+		attributes := getAvailableIPAddressAttributes(d, i)
+		if ipAddress["dns_name"] != attributes.dnsName ||
+			ipAddress["description"] != attributes.description ||
+			ipAddress["status"] != attributes.status ||
+			ipAddress["role"] != attributes.role {
+			changed = true
+		}
+		planned = append(planned, map[string]interface{}{
+			"id":          ipAddress["id"],
+			"ip_address":  ipAddress["ip_address"],
+			"dns_name":    attributes.dnsName,
+			"description": attributes.description,
+			"status":      attributes.status,
+			"role":        attributes.role,
+		})
+	}
+	if !changed {
+		return nil
+	}
+	return d.SetNew("ip_addresses", planned)
+}
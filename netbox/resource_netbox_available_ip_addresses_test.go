@@ -0,0 +1,146 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxAvailableIPAddresses_basic(t *testing.T) {
+	testPrefix := "1.1.9.0/24"
+	testName := testAccGetTestName("available_ips")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "%[1]s"
+  status = "active"
+}
+resource "netbox_available_ip_addresses" "test" {
+  prefix_id     = netbox_prefix.test.id
+  address_count = 3
+  dns_name      = "%[2]s-{number}.example.com"
+  description   = "node {index}"
+
+  address {}
+  address {
+    role        = "vip"
+    description = "virtual"
+  }
+}`, testPrefix, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.#", "3"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.ip_address", "1.1.9.1/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.dns_name", testName+"-1.example.com"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.description", "node 0"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.status", "active"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.1.ip_address", "1.1.9.2/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.1.role", "vip"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.1.description", "virtual"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.2.ip_address", "1.1.9.3/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.2.dns_name", testName+"-3.example.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "%[1]s"
+  status = "active"
+}
+resource "netbox_available_ip_addresses" "test" {
+  prefix_id     = netbox_prefix.test.id
+  address_count = 3
+  status        = "reserved"
+  dns_name      = "%[2]s-{index}.example.com"
+}`, testPrefix, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.#", "3"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.ip_address", "1.1.9.1/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.dns_name", testName+"-0.example.com"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.1.role", ""),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.2.status", "reserved"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxAvailableIPAddresses_contiguous(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "netbox_ip_range" "test" {
+  start_address = "1.1.10.1/24"
+  end_address   = "1.1.10.20/24"
+}
+resource "netbox_ip_address" "test" {
+  ip_address = "1.1.10.2/24"
+  status     = "active"
+}
+resource "netbox_available_ip_addresses" "test" {
+  ip_range_id   = netbox_ip_range.test.id
+  address_count = 2
+  contiguous    = true
+
+  depends_on = [netbox_ip_address.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.0.ip_address", "1.1.10.3/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "ip_addresses.1.ip_address", "1.1.10.4/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindContiguousAddresses(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		available []string
+		count     int
+		expected  []string
+	}{
+		{
+			name:      "FirstRun",
+			available: []string{"10.0.0.1/24", "10.0.0.2/24", "10.0.0.3/24"},
+			count:     2,
+			expected:  []string{"10.0.0.1/24", "10.0.0.2/24"},
+		},
+		{
+			name:      "SkipsGaps",
+			available: []string{"10.0.0.1/24", "10.0.0.3/24", "10.0.0.4/24", "10.0.0.6/24", "10.0.0.7/24", "10.0.0.8/24"},
+			count:     3,
+			expected:  []string{"10.0.0.6/24", "10.0.0.7/24", "10.0.0.8/24"},
+		},
+		{
+			name:      "IPv6",
+			available: []string{"2001:db8::1/64", "2001:db8::3/64", "2001:db8::4/64"},
+			count:     2,
+			expected:  []string{"2001:db8::3/64", "2001:db8::4/64"},
+		},
+		{
+			name:      "NotFound",
+			available: []string{"10.0.0.1/24", "10.0.0.3/24"},
+			count:     2,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := findContiguousAddresses(tt.available, tt.count)
+			if tt.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
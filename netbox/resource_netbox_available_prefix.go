@@ -3,11 +3,14 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,13 +22,47 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 		Update: resourceNetboxPrefixUpdate,
 		Delete: resourceNetboxPrefixDelete,
 
+		CustomizeDiff: customdiff.Sequence(allocationKeyCustomizeDiff, resourceNetboxAvailablePrefixCustomizeDiff),
+
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 
 		Schema: map[string]*schema.Schema{
 			"parent_prefix_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"parent_prefix_id", "parent_prefix_ids", "parent_prefix_filter"},
+				Description:  "The ID of the prefix to allocate the prefix from. If `parent_prefix_ids` or `parent_prefix_filter` is given, this is the ID of the parent prefix the prefix was allocated from.",
+			},
+			"parent_prefix_ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"parent_prefix_id", "parent_prefix_ids", "parent_prefix_filter"},
+				Description:  "The IDs of the prefixes to allocate the prefix from, in order of preference. The prefix is allocated from the first parent prefix with enough space available. Changing the list only replaces the prefix if the parent prefix it was allocated from is no longer listed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"parent_prefix_filter": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"parent_prefix_id", "parent_prefix_ids", "parent_prefix_filter"},
+				Description:  "Filters selecting the prefixes to allocate the prefix from, e.g. by `role_id`, `tag` or `site_id`. The prefix is allocated from the first matching prefix with enough space available, in the order returned by Netbox. Changing the filters only replaces the prefix if the parent prefix it was allocated from no longer matches them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the field to filter on. Any filter supported by the prefixes API endpoint can be used, including lookup expressions like `prefix__ic`. Custom fields can be filtered on with `cf_<name>`. A filter name can be given multiple times.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value to pass to the specified filter.",
+						},
+					},
+				},
 			},
 			"prefix_length": {
 				Type:         schema.TypeInt,
@@ -91,8 +128,10 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			customFieldsKey: customFieldsSchema,
-			tagsKey:         tagsSchema,
+			customFieldsKey:       customFieldsSchema,
+			tagsKey:               tagsSchema,
+			allocationKeyKey:      allocationKeySchema,
+			allocationKeyFieldKey: allocationKeyFieldSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(c context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -133,24 +172,165 @@ func resourceNetboxAvailablePrefixParseImport(importStr string) (int, string, in
 func resourceNetboxAvailablePrefixCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*providerState)
 
-	parentPrefixID := int64(d.Get("parent_prefix_id").(int))
+	parentPrefixIDs, err := getAvailablePrefixParentIDs(api, d)
+	if err != nil {
+		return err
+	}
 	prefixLength := int64(d.Get("prefix_length").(int))
+
+	for _, parentPrefixID := range parentPrefixIDs {
+		prefix, err := findAllocatedPrefix(api, d, parentPrefixID, prefixLength)
+		if err != nil {
+			return err
+		}
+		if prefix != nil {
+			id, err := getRawID(prefix)
+			if err != nil {
+				return err
+			}
+			d.SetId(strconv.FormatInt(id, 10))
+			d.Set("prefix", fmt.Sprint(prefix["prefix"]))
+			d.Set("parent_prefix_id", parentPrefixID)
+			return resourceNetboxPrefixUpdate(d, m)
+		}
+	}
+
 	data := models.PrefixLength{
 		PrefixLength: &prefixLength,
 	}
 	if cf, ok := d.GetOk(customFieldsKey); ok {
-		data.CustomFields = cf
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
+	}
+
+	for i, parentPrefixID := range parentPrefixIDs {
+		params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parentPrefixID).WithData(&data)
+
+		res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
+		if err != nil {
+			// Netbox responds with 409 Conflict if the parent prefix is full.
+			if errresp, ok := err.(*ipam.IpamPrefixesAvailablePrefixesCreateDefault); ok && errresp.Code() == 409 && i < len(parentPrefixIDs)-1 {
+				continue
+			}
+			return err
+		}
+
+		payload := res.GetPayload()
+		d.SetId(strconv.FormatInt(payload.ID, 10))
+		d.Set("prefix", payload.Prefix)
+		d.Set("parent_prefix_id", parentPrefixID)
+
+		return resourceNetboxPrefixUpdate(d, m)
 	}
-	params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parentPrefixID).WithData(&data)
+	return fmt.Errorf("no parent prefixes to allocate the prefix from")
+}
 
-	res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
+// resourceNetboxAvailablePrefixCustomizeDiff replaces the prefix if the
+// parent prefixes change and no longer include the parent prefix it was
+// allocated from. Other changes of the parent prefixes, e.g. adding a fallback
+// prefix, keep the prefix.
+func resourceNetboxAvailablePrefixCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	oldParentID, _ := d.GetChange("parent_prefix_id")
+	parentID := int64(oldParentID.(int))
+
+	if d.HasChange("parent_prefix_ids") {
+		if !d.NewValueKnown("parent_prefix_ids") {
+			return d.ForceNew("parent_prefix_ids")
+		}
+		if ids, ok := d.GetOk("parent_prefix_ids"); ok && !slices.Contains(ids.([]interface{}), interface{}(int(parentID))) {
+			return d.ForceNew("parent_prefix_ids")
+		}
+	}
+
+	if d.HasChange("parent_prefix_filter") {
+		if !d.NewValueKnown("parent_prefix_filter") {
+			return d.ForceNew("parent_prefix_filter")
+		}
+		if _, ok := d.GetOk("parent_prefix_filter"); !ok {
+			return nil
+		}
+
+		api := m.(*providerState)
+		params := ipam.NewIpamPrefixesListParams().WithID(strToPtr(strconv.FormatInt(parentID, 10))).WithLimit(int64ToPtr(1))
+		query, err := getFilterQueryFromKey(d, "parent_prefix_filter", params, nil)
+		if err != nil {
+			return err
+		}
+		res, err := api.Ipam.IpamPrefixesList(params, nil, withQuery(query))
+		if err != nil {
+			return err
+		}
+		if *res.GetPayload().Count == 0 {
+			return d.ForceNew("parent_prefix_filter")
+		}
+	}
+	return nil
+}
+
+// getAvailablePrefixParentIDs returns the IDs of the prefixes to allocate
+// the prefix from, in order of preference.
+func getAvailablePrefixParentIDs(api *providerState, d *schema.ResourceData) ([]int64, error) {
+	if parentPrefixID, ok := d.GetOk("parent_prefix_id"); ok {
+		return []int64{int64(parentPrefixID.(int))}, nil
+	}
+
+	var ids []int64
+	if parentPrefixIDs, ok := d.GetOk("parent_prefix_ids"); ok {
+		for _, id := range parentPrefixIDs.([]interface{}) {
+			ids = append(ids, int64(id.(int)))
+		}
+		return ids, nil
+	}
+
+	params := ipam.NewIpamPrefixesListParams()
+	query, err := getFilterQueryFromKey(d, "parent_prefix_filter", params, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	prefixes, err := listAll(0, 0, func(limit, offset *int64) ([]*models.Prefix, bool, error) {
+		params.Limit = limit
+		params.Offset = offset
+		res, err := api.Ipam.IpamPrefixesList(params, nil, withQuery(query))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.GetPayload().Results, res.GetPayload().Next != nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(prefixes) == 0 {
+		return nil, fmt.Errorf("no prefixes match the parent prefix filter")
+	}
+	for _, p := range prefixes {
+		ids = append(ids, p.ID)
+	}
+	return ids, nil
+}
 
-	payload := res.GetPayload()
-	d.SetId(strconv.FormatInt(payload.ID, 10))
-	d.Set("prefix", payload.Prefix)
+// findAllocatedPrefix looks up a prefix of the given length within the
+// parent prefix that carries the allocation key of the resource.
+func findAllocatedPrefix(api *providerState, d *schema.ResourceData, parentPrefixID, prefixLength int64) (map[string]interface{}, error) {
+	if key, _ := getAllocationKey(d); key == "" {
+		return nil, nil
+	}
 
-	return resourceNetboxPrefixUpdate(d, m)
+	res, err := api.Ipam.IpamPrefixesRead(ipam.NewIpamPrefixesReadParams().WithID(parentPrefixID), nil)
+	if err != nil {
+		return nil, err
+	}
+	parent := res.GetPayload()
+
+	query := url.Values{
+		"within":      {*parent.Prefix},
+		"mask_length": {strconv.FormatInt(prefixLength, 10)},
+		"vrf_id":      {vrfFilterValue(int64(d.Get("vrf_id").(int)))},
+	}
+	return api.findAllocatedObject(context.Background(), d, "ipam/prefixes", query, nil)
 }
@@ -3,6 +3,7 @@ package netbox
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
@@ -308,6 +309,200 @@ resource "netbox_available_prefix" "with_site_group_id" {
 	})
 }
 
+func TestAccNetboxAvailablePrefix_allocationKey(t *testing.T) {
+	testName := testAccGetTestName("available_prefix_key")
+	testField := strings.ReplaceAll(testAccGetTestName("allocation_key"), "-", "_")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[2]s"
+  type          = "text"
+  content_types = ["ipam.prefix"]
+}
+resource "netbox_prefix" "parent" {
+  prefix = "1.2.12.0/24"
+  status = "container"
+}
+resource "netbox_prefix" "existing" {
+  prefix = "1.2.12.64/26"
+  status = "active"
+  custom_fields = {
+    "${netbox_custom_field.test.name}" = "%[1]s"
+  }
+
+  depends_on = [netbox_prefix.parent]
+}
+resource "netbox_available_prefix" "test" {
+  parent_prefix_id     = netbox_prefix.parent.id
+  prefix_length        = 26
+  status               = "active"
+  description          = "adopted"
+  allocation_key       = "%[1]s"
+  allocation_key_field = "cf_${netbox_custom_field.test.name}"
+
+  depends_on = [netbox_prefix.existing]
+}`, testName, testField),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_available_prefix.test", "id", "netbox_prefix.existing", "id"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "prefix", "1.2.12.64/26"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "description", "adopted"),
+					resource.TestCheckNoResourceAttr("netbox_available_prefix.test", "custom_fields."+testField),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAvailablePrefixKept records the ID of the given resource on
+// the first call and checks that it is unchanged on later calls, i.e. that the
+// prefix was not allocated again.
+func testAccCheckAvailablePrefixKept(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+		if *id != "" && *id != rs.Primary.ID {
+			return fmt.Errorf("expected %s to keep ID %s, got %s", n, *id, rs.Primary.ID)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func TestAccNetboxAvailablePrefix_multipleParents(t *testing.T) {
+	testName := testAccGetTestName("available_prefix_parents")
+	var byIDs, byFilter string
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipam_role" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+}
+resource "netbox_prefix" "full" {
+  prefix  = "1.2.13.0/28"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_prefix" "spill" {
+  prefix  = "1.2.14.0/24"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_available_prefix" "by_ids" {
+  parent_prefix_ids = [netbox_prefix.full.id, netbox_prefix.spill.id]
+  prefix_length     = 26
+  status            = "active"
+}
+resource "netbox_available_prefix" "by_filter" {
+  prefix_length = 26
+  status        = "active"
+
+  parent_prefix_filter {
+    name  = "role_id"
+    value = netbox_ipam_role.test.id
+  }
+
+  depends_on = [netbox_prefix.full, netbox_prefix.spill, netbox_available_prefix.by_ids]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_available_prefix.by_ids", "parent_prefix_id", "netbox_prefix.spill", "id"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.by_ids", "prefix", "1.2.14.0/26"),
+					resource.TestCheckResourceAttrPair("netbox_available_prefix.by_filter", "parent_prefix_id", "netbox_prefix.spill", "id"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.by_filter", "prefix", "1.2.14.64/26"),
+					testAccCheckAvailablePrefixKept("netbox_available_prefix.by_ids", &byIDs),
+					testAccCheckAvailablePrefixKept("netbox_available_prefix.by_filter", &byFilter),
+				),
+			},
+			{
+				// adding a fallback and narrowing the filter keep the allocated prefixes
+				Config: fmt.Sprintf(`resource "netbox_ipam_role" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+}
+resource "netbox_prefix" "full" {
+  prefix  = "1.2.13.0/28"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_prefix" "spill" {
+  prefix  = "1.2.14.0/24"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_prefix" "fallback" {
+  prefix  = "1.2.15.0/24"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_available_prefix" "by_ids" {
+  parent_prefix_ids = [netbox_prefix.full.id, netbox_prefix.spill.id, netbox_prefix.fallback.id]
+  prefix_length     = 26
+  status            = "active"
+}
+resource "netbox_available_prefix" "by_filter" {
+  prefix_length = 26
+  status        = "active"
+
+  parent_prefix_filter {
+    name  = "role_id"
+    value = netbox_ipam_role.test.id
+  }
+  parent_prefix_filter {
+    name  = "prefix"
+    value = netbox_prefix.spill.prefix
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_prefix.by_ids", "prefix", "1.2.14.0/26"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.by_filter", "prefix", "1.2.14.64/26"),
+					testAccCheckAvailablePrefixKept("netbox_available_prefix.by_ids", &byIDs),
+					testAccCheckAvailablePrefixKept("netbox_available_prefix.by_filter", &byFilter),
+				),
+			},
+			{
+				// dropping the parent prefix the prefix was allocated from replaces it
+				Config: fmt.Sprintf(`resource "netbox_ipam_role" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+}
+resource "netbox_prefix" "full" {
+  prefix  = "1.2.13.0/28"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_prefix" "spill" {
+  prefix  = "1.2.14.0/24"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_prefix" "fallback" {
+  prefix  = "1.2.15.0/24"
+  status  = "container"
+  role_id = netbox_ipam_role.test.id
+}
+resource "netbox_available_prefix" "by_ids" {
+  parent_prefix_ids = [netbox_prefix.fallback.id]
+  prefix_length     = 26
+  status            = "active"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_available_prefix.by_ids", "parent_prefix_id", "netbox_prefix.fallback", "id"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.by_ids", "prefix", "1.2.15.0/26"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_prefix", &resource.Sweeper{
 		Name:         "netbox_available_prefix",
@@ -1,6 +1,9 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
+	"net/url"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
@@ -15,6 +18,8 @@ func resourceNetboxAvailableVLAN() *schema.Resource {
 		Update: resourceNetboxAvailableVLANUpdate,
 		Delete: resourceNetboxAvailableVLANDelete,
 
+		CustomizeDiff: allocationKeyCustomizeDiff,
+
 		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://netbox.readthedocs.io/en/stable/models/ipam/vlan/):
 
 > A VLAN represents an isolated Layer 2 domain identified by a numeric ID (1–4094). VLANs may be assigned to specific sites or marked as global.
@@ -64,7 +69,9 @@ This resource will retrieve the next available VLAN ID from a given VLAN group (
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:               tagsSchema,
+			allocationKeyKey:      allocationKeySchema,
+			allocationKeyFieldKey: allocationKeyFieldSchema,
 		},
 	}
 }
@@ -73,6 +80,24 @@ func resourceNetboxAvailableVLANCreate(d *schema.ResourceData, m interface{}) er
 	api := m.(*providerState)
 	groupID := int64(d.Get("group_id").(int))
 
+	allocated, err := api.findAllocatedObject(context.Background(), d, "ipam/vlans", url.Values{"group_id": {strconv.FormatInt(groupID, 10)}}, nil)
+	if err != nil {
+		return err
+	}
+	if allocated != nil {
+		id, err := getRawID(allocated)
+		if err != nil {
+			return err
+		}
+		vid, err := strconv.Atoi(fmt.Sprint(allocated["vid"]))
+		if err != nil {
+			return err
+		}
+		d.SetId(strconv.FormatInt(id, 10))
+		d.Set("vid", vid)
+		return resourceNetboxAvailableVLANUpdate(d, m)
+	}
+
 	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	if err != nil {
 		return err
@@ -86,6 +111,9 @@ func resourceNetboxAvailableVLANCreate(d *schema.ResourceData, m interface{}) er
 		Status:      d.Get("status").(string),
 		Tags:        tags,
 	}
+	if err := api.setAllocationKey(d, &data.Description, &data.Tags, &data.CustomFields); err != nil {
+		return err
+	}
 
 	params := ipam.NewIpamVlanGroupsAvailableVlansCreateParams().WithID(groupID).WithData(data)
 	resp, err := api.Ipam.IpamVlanGroupsAvailableVlansCreate(params, nil)
@@ -159,6 +187,7 @@ func resourceNetboxAvailableVLANRead(d *schema.ResourceData, m interface{}) erro
 	}
 
 	api.readTags(d, vlan.Tags)
+	stripAllocationKey(d)
 
 	return nil
 }
@@ -183,6 +212,9 @@ func resourceNetboxAvailableVLANUpdate(d *schema.ResourceData, m interface{}) er
 	if err_tags != nil {
 		return err_tags
 	}
+	if err := api.setAllocationKey(d, &data.Description, &data.Tags, &data.CustomFields); err != nil {
+		return err
+	}
 
 	params := ipam.NewIpamVlansUpdateParams().
 		WithID(id).
@@ -345,3 +345,49 @@ resource "netbox_available_vlan" "vlan" {
 		},
 	})
 }
+
+// TestAccNetboxAvailableVLAN_allocationKey verifies that an existing VLAN
+// carrying the allocation key is adopted instead of allocating a new VID.
+func TestAccNetboxAvailableVLAN_allocationKey(t *testing.T) {
+	testName := testAccGetTestName("available_vlan_key")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan_group" "test" {
+  name       = "%[1]s"
+  slug       = "%[1]s"
+  vid_ranges = [[1, 20]]
+}
+
+resource "netbox_vlan" "existing" {
+  name     = "%[1]s"
+  vid      = 15
+  group_id = netbox_vlan_group.test.id
+  tags     = [netbox_tag.test.name]
+}
+
+resource "netbox_available_vlan" "test" {
+  name                 = "%[1]s"
+  status               = "active"
+  group_id             = netbox_vlan_group.test.id
+  allocation_key       = netbox_tag.test.name
+  allocation_key_field = "tag"
+
+  depends_on = [netbox_vlan.existing]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_available_vlan.test", "id", "netbox_vlan.existing", "id"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "vid", "15"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -115,9 +115,12 @@ func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimCablesCreateParams().WithData(&data)
@@ -181,7 +184,7 @@ func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", cable.Description)
 	d.Set("comments", cable.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -219,9 +222,12 @@ func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimCablesPartialUpdateParams().WithID(id).WithData(&data)
@@ -133,9 +133,12 @@ func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, m interface{
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := circuits.NewCircuitsCircuitTerminationsCreateParams().WithData(&data)
@@ -215,7 +218,7 @@ func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, m interface{})
 
 	api.readTags(d, term.Tags)
 
-	cf := getCustomFields(term.CustomFields)
+	cf := api.getCustomFields(term.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -280,9 +283,12 @@ func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, m interface{
 		return err
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := circuits.NewCircuitsCircuitTerminationsPartialUpdateParams().WithID(id).WithData(&data)
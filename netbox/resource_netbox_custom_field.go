@@ -32,7 +32,9 @@ func resourceCustomField() *schema.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					models.CustomFieldTypeValueText,
+					models.CustomFieldTypeValueLongtext,
 					models.CustomFieldTypeValueInteger,
+					models.CustomFieldTypeValueDecimal,
 					models.CustomFieldTypeValueBoolean,
 					models.CustomFieldTypeValueDate,
 					models.CustomFieldTypeValueURL,
@@ -131,13 +133,11 @@ func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) erro
 		data.ObjectTypes = objectTypes
 	}
 
-	vmax, ok := d.GetOk("validation_maximum")
-	if ok {
-		data.ValidationMaximum = int64ToPtr(int64(vmax.(int)))
+	if !d.GetRawConfig().GetAttr("validation_maximum").IsNull() {
+		data.ValidationMaximum = int64ToPtr(int64(d.Get("validation_maximum").(int)))
 	}
-	vmin, ok := d.GetOk("validation_minimum")
-	if ok {
-		data.ValidationMinimum = int64ToPtr(int64(vmin.(int)))
+	if !d.GetRawConfig().GetAttr("validation_minimum").IsNull() {
+		data.ValidationMinimum = int64ToPtr(int64(d.Get("validation_minimum").(int)))
 	}
 
 	params := extras.NewExtrasCustomFieldsUpdateParams().WithID(id).WithData(data)
@@ -146,6 +146,11 @@ func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
+	// the type of the custom field might have changed
+	oldName, newName := d.GetChange("name")
+	api.forgetCustomFieldDefinition(oldName.(string))
+	api.forgetCustomFieldDefinition(newName.(string))
+
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
 	return resourceNetboxCustomFieldRead(d, m)
@@ -181,13 +186,11 @@ func resourceNetboxCustomFieldCreate(d *schema.ResourceData, m interface{}) erro
 		data.ObjectTypes = objectTypes
 	}
 
-	vmax, ok := d.GetOk("validation_maximum")
-	if ok {
-		data.ValidationMaximum = int64ToPtr(int64(vmax.(int)))
+	if !d.GetRawConfig().GetAttr("validation_maximum").IsNull() {
+		data.ValidationMaximum = int64ToPtr(int64(d.Get("validation_maximum").(int)))
 	}
-	vmin, ok := d.GetOk("validation_minimum")
-	if ok {
-		data.ValidationMinimum = int64ToPtr(int64(vmin.(int)))
+	if !d.GetRawConfig().GetAttr("validation_minimum").IsNull() {
+		data.ValidationMinimum = int64ToPtr(int64(d.Get("validation_minimum").(int)))
 	}
 
 	params := extras.NewExtrasCustomFieldsCreateParams().WithData(data)
@@ -263,5 +266,6 @@ func resourceNetboxCustomFieldDelete(d *schema.ResourceData, m interface{}) erro
 		}
 		return err
 	}
+	api.forgetCustomFieldDefinition(d.Get("name").(string))
 	return nil
 }
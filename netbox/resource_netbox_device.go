@@ -3,7 +3,10 @@ package netbox
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -21,6 +24,7 @@ func resourceNetboxDevice() *schema.Resource {
 		ReadContext:   resourceNetboxDeviceRead,
 		UpdateContext: resourceNetboxDeviceUpdate,
 		DeleteContext: resourceNetboxDeviceDelete,
+		CustomizeDiff: resourceNetboxDeviceCustomizeDiff,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/devices/#devices):
 
@@ -102,13 +106,38 @@ func resourceNetboxDevice() *schema.Resource {
 			"rack_face": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				RequiredWith: []string{"rack_position"},
 				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceRackFaceOptions, false),
-				Description:  buildValidValueDescription(resourceNetboxDeviceRackFaceOptions),
+				Description:  buildValidValueDescription(resourceNetboxDeviceRackFaceOptions) + ". Requires `rack_position` or `rack_position_auto` to be set.",
 			},
 			"rack_position": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "The lowest rack unit occupied by the device. Computed if `rack_position_auto` is set.",
+			},
+			"rack_position_auto": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rack_position"},
+				Description:   "Places the device at the first free units of the rack large enough to hold it. Full-depth devices require the units to be free on both faces. Requires `rack_id` and `rack_face` to be set. The position is looked up when the device is created or moved to another rack or face, and kept afterwards.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of units to reserve. Defaults to the height of the device type.",
+						},
+						"prefer": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "bottom",
+							ValidateFunc: validation.StringInSlice([]string{"bottom", "top"}, false),
+							Description:  "Whether to place the device as low or as high in the rack as possible. " + buildValidValueDescription([]string{"bottom", "top"}),
+						},
+					},
+				},
 			},
 			"virtual_chassis_id": {
 				Type:         schema.TypeInt,
@@ -135,9 +164,7 @@ func resourceNetboxDevice() *schema.Resource {
 			},
 			customFieldsKey: customFieldsSchema,
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: naturalKeyImporter(resolveNetboxDeviceImportKey),
 	}
 }
 
@@ -218,7 +245,11 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 	if ok && rackPosition.(float64) > 0 {
 		data.Position = float64ToPtr(rackPosition.(float64))
 	} else {
-		data.Position = nil
+		var err error
+		data.Position, err = getAutoRackPosition(ctx, api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	data.VirtualChassis = getOptionalInt(d, "virtual_chassis_id")
@@ -234,9 +265,12 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data.CustomFields = customFields
 	}
 
 	var err error
@@ -353,7 +387,7 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("config_template_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -470,6 +504,13 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 	data.Rack = getOptionalInt(d, "rack_id")
 	data.Face = getOptionalStr(d, "rack_face", false)
 	data.Position = getOptionalFloat(d, "rack_position")
+	if data.Position == nil {
+		var err error
+		data.Position, err = getAutoRackPosition(ctx, api, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	data.VirtualChassis = getOptionalInt(d, "virtual_chassis_id")
 	data.VcPosition = getOptionalInt(d, "virtual_chassis_position")
@@ -484,9 +525,12 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data.CustomFields = customFields
 	}
 
 	var err error
@@ -595,3 +639,102 @@ func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m i
 	}
 	return diags
 }
+
+// resolveNetboxDeviceImportKey resolves a key of the form `name@site-slug` or
+// `name` to the ID of the device.
+func resolveNetboxDeviceImportKey(api *providerState, key string) (int64, error) {
+	name, site, found := strings.Cut(key, "@")
+	params := dcim.NewDcimDevicesListParams().WithName(&name)
+	if found {
+		params.Site = &site
+	}
+	res, err := api.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	return getSingleImportResult("device", key, *res.GetPayload().Count, func() int64 {
+		return res.GetPayload().Results[0].ID
+	})
+}
+
+// getAutoRackPosition looks up a free position for the device in its rack if
+// rack_position_auto is set. Otherwise, no position is returned.
+func getAutoRackPosition(ctx context.Context, api *providerState, d *schema.ResourceData) (*float64, error) {
+	autoValue, ok := d.GetOk("rack_position_auto")
+	if !ok {
+		return nil, nil
+	}
+	auto, _ := autoValue.([]interface{})[0].(map[string]interface{})
+
+	params := dcim.NewDcimDeviceTypesReadParams().WithID(int64(d.Get("device_type_id").(int)))
+	res, err := api.Dcim.DcimDeviceTypesRead(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	deviceType := res.GetPayload()
+
+	height, _ := auto["height"].(int)
+	if height == 0 {
+		if deviceType.UHeight != nil {
+			height = int(math.Ceil(*deviceType.UHeight))
+		}
+		if height == 0 {
+			return nil, fmt.Errorf("rack_position_auto requires a height for device types of 0U")
+		}
+	}
+
+	// Full-depth devices also need the units on the opposite face to be free.
+	faces := []string{d.Get("rack_face").(string)}
+	if deviceType.IsFullDepth {
+		if faces[0] == "front" {
+			faces = append(faces, "rear")
+		} else {
+			faces = append(faces, "front")
+		}
+	}
+
+	// The units occupied by the device itself are free if it is moved.
+	var deviceID int64
+	if d.Id() != "" {
+		deviceID, _ = strconv.ParseInt(d.Id(), 10, 64)
+	}
+	units := make([][]rackUnit, 0, len(faces))
+	for _, face := range faces {
+		faceUnits, err := api.getRackUnits(ctx, int64(d.Get("rack_id").(int)), face, deviceID)
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, faceUnits)
+	}
+	position, err := findFreeRackPosition(height, auto["prefer"] == "top", units...)
+	if err != nil {
+		return nil, err
+	}
+	return &position, nil
+}
+
+// resourceNetboxDeviceCustomizeDiff plans the lookup of a new rack position
+// if rack_position_auto is set. Without it, a rack position removed from the
+// configuration is removed from the device.
+func resourceNetboxDeviceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	rackPositionConfigured := !config.GetAttr("rack_position").IsNull()
+	_, auto := d.GetOk("rack_position_auto")
+
+	if !config.GetAttr("rack_face").IsNull() && !rackPositionConfigured && !auto {
+		return fmt.Errorf("rack_face requires rack_position or rack_position_auto to be set")
+	}
+	if auto && (config.GetAttr("rack_id").IsNull() || config.GetAttr("rack_face").IsNull()) {
+		return fmt.Errorf("rack_position_auto requires rack_id and rack_face to be set")
+	}
+
+	switch {
+	case auto:
+		if d.Id() == "" || d.HasChanges("rack_id", "rack_face", "rack_position_auto") || d.Get("rack_position").(float64) == 0 {
+			return d.SetNewComputed("rack_position")
+		}
+	case !rackPositionConfigured && d.Get("rack_position").(float64) != 0:
+		return d.SetNew("rack_position", 0)
+	}
+	return nil
+}
@@ -66,9 +66,12 @@ func resourceNetboxDeviceBayCreate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimDeviceBaysCreateParams().WithData(&data)
@@ -115,7 +118,7 @@ func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("description", deviceBay.Description)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -143,9 +146,12 @@ func resourceNetboxDeviceBayUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimDeviceBaysPartialUpdateParams().WithID(id).WithData(&data)
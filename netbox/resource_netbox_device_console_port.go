@@ -84,9 +84,12 @@ func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimConsolePortsCreateParams().WithData(&data)
@@ -150,7 +153,7 @@ func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{})
 	d.Set("description", consolePort.Description)
 	d.Set("mark_connected", consolePort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -181,9 +184,12 @@ func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimConsolePortsPartialUpdateParams().WithID(id).WithData(&data)
@@ -2,7 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -12,6 +14,17 @@ import (
 )
 
 var resourceNetboxDeviceInterfaceModeOptions = []string{"access", "tagged", "tagged-all", "q-in-q"}
+var resourceNetboxDeviceInterfaceRfRoleOptions = []string{"ap", "station"}
+
+// writableInterface is an interface as sent to the API. Unlike the go-netbox
+// model, it always sends the wireless attributes, so that removing them from
+// the configuration clears them.
+type writableInterface struct {
+	*models.WritableInterface
+	RfRole    string `json:"rf_role"`
+	RfChannel string `json:"rf_channel"`
+	TxPower   *int64 `json:"tx_power"`
+}
 
 func resourceNetboxDeviceInterface() *schema.Resource {
 	return &schema.Resource{
@@ -115,10 +128,33 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"rf_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfaceRfRoleOptions, false),
+				Description:  "The wireless role of the interface. " + buildValidValueDescription(resourceNetboxDeviceInterfaceRfRoleOptions),
+			},
+			"rf_channel": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The wireless channel of the interface, e.g. `2.4g-1-2412-22` or `5g-36-5180-20`.",
+			},
+			"tx_power": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 127),
+				Description:  "The transmit power of the interface in dBm.",
+			},
+			"wireless_lans": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the wireless LANs the interface is attached to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: naturalKeyImporter(resolveNetboxDeviceInterfaceImportKey),
 	}
 }
 
@@ -152,7 +188,9 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		Tags:         tags,
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
-		WirelessLans: []int64{},
+		RfRole:       getOptionalStr(d, "rf_role", false),
+		RfChannel:    getOptionalStr(d, "rf_channel", false),
+		WirelessLans: toInt64List(d.Get("wireless_lans")),
 		Vdcs:         []int64{},
 	}
 	if lag, ok := d.Get("lag_device_interface_id").(int); ok && lag != 0 {
@@ -170,6 +208,9 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 	if untaggedVlan, ok := d.Get("untagged_vlan").(int); ok && untaggedVlan != 0 {
 		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
 	}
+	if !d.GetRawConfig().GetAttr("tx_power").IsNull() {
+		data.TxPower = int64ToPtr(int64(d.Get("tx_power").(int)))
+	}
 
 	params := dcim.NewDcimInterfacesCreateParams().WithData(&data)
 
@@ -230,6 +271,22 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 	if iface.UntaggedVlan != nil {
 		d.Set("untagged_vlan", iface.UntaggedVlan.ID)
 	}
+	if iface.RfRole != nil {
+		d.Set("rf_role", iface.RfRole.Value)
+	} else {
+		d.Set("rf_role", nil)
+	}
+	if iface.RfChannel != nil {
+		d.Set("rf_channel", iface.RfChannel.Value)
+	} else {
+		d.Set("rf_channel", nil)
+	}
+	d.Set("tx_power", iface.TxPower)
+	var wirelessLans []int64
+	for _, lan := range iface.WirelessLans {
+		wirelessLans = append(wirelessLans, lan.ID)
+	}
+	d.Set("wireless_lans", wirelessLans)
 	if iface.MacAddresses != nil {
 		var mac_addresses []map[string]interface{}
 		for i, mac := range iface.MacAddresses {
@@ -270,19 +327,23 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 	taggedVlans := toInt64List(d.Get("tagged_vlans"))
 	deviceID := int64(d.Get("device_id").(int))
 
-	data := models.WritableInterface{
-		Name:         &name,
-		Description:  description,
-		Label:        label,
-		Type:         &interfaceType,
-		Enabled:      enabled,
-		MgmtOnly:     mgmtonly,
-		Mode:         mode,
-		Tags:         tags,
-		TaggedVlans:  taggedVlans,
-		Device:       &deviceID,
-		WirelessLans: []int64{},
-		Vdcs:         []int64{},
+	data := writableInterface{
+		WritableInterface: &models.WritableInterface{
+			Name:         &name,
+			Description:  description,
+			Label:        label,
+			Type:         &interfaceType,
+			Enabled:      enabled,
+			MgmtOnly:     mgmtonly,
+			Mode:         mode,
+			Tags:         tags,
+			TaggedVlans:  taggedVlans,
+			Device:       &deviceID,
+			WirelessLans: toInt64List(d.Get("wireless_lans")),
+			Vdcs:         []int64{},
+		},
+		RfRole:    getOptionalStr(d, "rf_role", false),
+		RfChannel: getOptionalStr(d, "rf_channel", false),
 	}
 
 	if d.HasChange("lag_device_interface_id") {
@@ -305,10 +366,11 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		untaggedvlan := int64(d.Get("untagged_vlan").(int))
 		data.UntaggedVlan = &untaggedvlan
 	}
+	if !d.GetRawConfig().GetAttr("tx_power").IsNull() {
+		data.TxPower = int64ToPtr(int64(d.Get("tx_power").(int)))
+	}
 
-	params := dcim.NewDcimInterfacesPartialUpdateParams().WithID(id).WithData(&data)
-	_, err = api.Dcim.DcimInterfacesPartialUpdate(params, nil)
-	if err != nil {
+	if err := api.patchRaw(ctx, fmt.Sprintf("dcim/interfaces/%d", id), data, nil); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -341,3 +403,26 @@ func getIDsFromNestedVLANDevice(nestedvlans []*models.NestedVLAN) []int64 {
 	}
 	return vlans
 }
+
+// resolveNetboxDeviceInterfaceImportKey resolves a key of the form
+// `device-name/interface-name` to the ID of the interface. The device may be
+// given as `name@site-slug` as well.
+func resolveNetboxDeviceInterfaceImportKey(api *providerState, key string) (int64, error) {
+	device, name, found := strings.Cut(key, "/")
+	if !found {
+		return 0, fmt.Errorf("invalid import key %q, expected the numeric ID or <device>/<interface>", key)
+	}
+	deviceID, err := resolveNetboxDeviceImportKey(api, device)
+	if err != nil {
+		return 0, err
+	}
+
+	params := dcim.NewDcimInterfacesListParams().WithDeviceID(strToPtr(strconv.FormatInt(deviceID, 10))).WithName(&name)
+	res, err := api.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	return getSingleImportResult("interface", key, *res.GetPayload().Count, func() int64 {
+		return res.GetPayload().Results[0].ID
+	})
+}
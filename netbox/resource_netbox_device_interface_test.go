@@ -166,6 +166,12 @@ func TestAccNetboxDeviceInterface_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "netbox_device_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFromAttributes("%s@%s/%s", "netbox_device.test.name", "netbox_site.test.slug", "netbox_device_interface.test.name"),
+			},
 		},
 	})
 }
@@ -292,6 +298,66 @@ func TestAccNetboxDeviceInterface_vlans(t *testing.T) {
 	})
 }
 
+func TestAccNetboxDeviceInterface_wireless(t *testing.T) {
+	testSlug := "iface_wireless"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid = "%[1]s"
+}
+
+resource "netbox_device_interface" "test" {
+  name          = "%[1]s"
+  device_id     = netbox_device.test.id
+  type          = "ieee802.11ac"
+  rf_role       = "ap"
+  rf_channel    = "5g-36-5180-20"
+  tx_power      = 20
+  wireless_lans = [netbox_wireless_lan.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "type", "ieee802.11ac"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "rf_role", "ap"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "rf_channel", "5g-36-5180-20"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "tx_power", "20"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "wireless_lans.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_device_interface.test", "wireless_lans.*", "netbox_wireless_lan.test", "id"),
+				),
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid = "%[1]s"
+}
+
+resource "netbox_device_interface" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+  type      = "ieee802.11ac"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "rf_role", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "rf_channel", ""),
+					resource.TestCheckNoResourceAttr("netbox_device_interface.test", "tx_power"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "wireless_lans.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDeviceInterfaceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
 	conn := testAccProvider.Meta().(*providerState)
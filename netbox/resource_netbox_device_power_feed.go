@@ -117,9 +117,12 @@ func resourceNetboxPowerFeedCreate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimPowerFeedsCreateParams().WithData(&data)
@@ -199,7 +202,7 @@ func resourceNetboxPowerFeedRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", powerFeed.Description)
 	d.Set("comments", powerFeed.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -235,9 +238,12 @@ func resourceNetboxPowerFeedUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimPowerFeedsPartialUpdateParams().WithID(id).WithData(&data)
@@ -252,6 +252,93 @@ resource "netbox_device" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "netbox_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFromAttributes("%s@%s", "netbox_device.test.name", "netbox_site.test.slug"),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDevice_rackPositionAuto(t *testing.T) {
+	testSlug := "device_rack_auto"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device" "bottom" {
+  name = "%[1]s_bottom"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "front"
+  rack_position = 1
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "front"
+  rack_position_auto {
+    height = 2
+  }
+
+  depends_on = [netbox_device.bottom]
+}
+
+resource "netbox_device" "top" {
+  name = "%[1]s_top"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "rear"
+  rack_position_auto {
+    prefer = "top"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "rack_position", "2"),
+					resource.TestCheckResourceAttr("netbox_device.top", "rack_position", "48"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device" "bottom" {
+  name = "%[1]s_bottom"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "front"
+  rack_position = 1
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "front"
+  rack_position_auto {}
+
+  depends_on = [netbox_device.bottom]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "rack_position", "2"),
+				),
+			},
 		},
 	})
 }
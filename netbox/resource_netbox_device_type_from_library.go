@@ -0,0 +1,454 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// libraryComponentKind is a kind of component template listed in a
+// devicetype-library definition.
+type libraryComponentKind struct {
+	// key is the key listing the components in the definition.
+	key string
+	// path is the API endpoint of the component templates.
+	path string
+	// references maps attributes referencing another component template by
+	// name to the key of the referenced kind.
+	references map[string]string
+}
+
+// libraryComponentKinds are the kinds of component templates in the order
+// they are created. Component templates referencing others come after them.
+var libraryComponentKinds = []libraryComponentKind{
+	{key: "console-ports", path: "dcim/console-port-templates"},
+	{key: "console-server-ports", path: "dcim/console-server-port-templates"},
+	{key: "power-ports", path: "dcim/power-port-templates"},
+	{key: "power-outlets", path: "dcim/power-outlet-templates", references: map[string]string{"power_port": "power-ports"}},
+	{key: "interfaces", path: "dcim/interface-templates"},
+	{key: "rear-ports", path: "dcim/rear-port-templates"},
+	{key: "front-ports", path: "dcim/front-port-templates", references: map[string]string{"rear_port": "rear-ports"}},
+	{key: "device-bays", path: "dcim/device-bay-templates"},
+	{key: "module-bays", path: "dcim/module-bay-templates"},
+	{key: "inventory-items", path: "dcim/inventory-item-templates"},
+}
+
+// libraryIgnoredKeys are attributes of a devicetype-library definition which
+// are not sent to the API.
+var libraryIgnoredKeys = []string{"front_image", "rear_image", "is_powered"}
+
+// deviceTypeLibraryDefinition is a parsed devicetype-library definition.
+type deviceTypeLibraryDefinition struct {
+	manufacturer string
+	deviceType   map[string]interface{}
+	components   map[string][]map[string]interface{}
+}
+
+func resourceNetboxDeviceTypeFromLibrary() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDeviceTypeFromLibraryCreate,
+		ReadContext:   resourceNetboxDeviceTypeFromLibraryRead,
+		UpdateContext: resourceNetboxDeviceTypeFromLibraryUpdate,
+		DeleteContext: resourceNetboxDeviceTypeFromLibraryDelete,
+		CustomizeDiff: resourceNetboxDeviceTypeFromLibraryCustomizeDiff,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Creates a device type and all of its component templates from a definition of the [community devicetype-library](https://github.com/netbox-community/devicetype-library).
+
+Component templates are matched by name when the definition changes. Templates no longer listed in the definition are deleted, new ones are created and all others are updated in place. Images referenced by the definition are not uploaded.
+
+Only the device type itself is read back from Netbox. Component templates changed or deleted outside of Terraform are not detected and are only corrected the next time the definition changes.`,
+
+		Schema: map[string]*schema.Schema{
+			"yaml": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := parseDeviceTypeLibraryDefinition(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s is not a valid devicetype-library definition: %w", k, err)}
+					}
+					return nil, nil
+				},
+				Description: "The YAML text of the devicetype-library definition, e.g. read with the `file` function.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The manufacturer of the device type. Defaults to the existing manufacturer named like the `manufacturer` of the definition.",
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"part_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"u_height": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseDeviceTypeLibraryDefinition parses the YAML text of a
+// devicetype-library definition. Attributes unknown to the provider are kept
+// and passed on to the API.
+func parseDeviceTypeLibraryDefinition(text string) (*deviceTypeLibraryDefinition, error) {
+	var deviceType map[string]interface{}
+	if err := yaml.Unmarshal([]byte(text), &deviceType); err != nil {
+		return nil, err
+	}
+	if deviceType == nil {
+		return nil, fmt.Errorf("definition is empty")
+	}
+
+	def := &deviceTypeLibraryDefinition{
+		deviceType: deviceType,
+		components: map[string][]map[string]interface{}{},
+	}
+
+	for _, key := range []string{"manufacturer", "model"} {
+		if value, ok := deviceType[key].(string); !ok || value == "" {
+			return nil, fmt.Errorf("%s is missing", key)
+		}
+	}
+	def.manufacturer = deviceType["manufacturer"].(string)
+	delete(deviceType, "manufacturer")
+	if _, ok := deviceType["slug"]; !ok {
+		deviceType["slug"] = getSlug(deviceType["model"].(string))
+	}
+	for _, key := range libraryIgnoredKeys {
+		delete(deviceType, key)
+	}
+
+	for _, kind := range libraryComponentKinds {
+		value, ok := deviceType[kind.key]
+		if !ok {
+			continue
+		}
+		delete(deviceType, kind.key)
+
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s is not a list", kind.key)
+		}
+		names := map[string]bool{}
+		for i, item := range list {
+			component, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s[%d] is not a mapping", kind.key, i)
+			}
+			name, ok := component["name"].(string)
+			if !ok || name == "" {
+				return nil, fmt.Errorf("%s[%d] has no name", kind.key, i)
+			}
+			if names[name] {
+				return nil, fmt.Errorf("%s contains %q more than once", kind.key, name)
+			}
+			names[name] = true
+			def.components[kind.key] = append(def.components[kind.key], component)
+		}
+	}
+
+	for _, kind := range libraryComponentKinds {
+		for _, component := range def.components[kind.key] {
+			for attr, referencedKey := range kind.references {
+				referenced, ok := component[attr].(string)
+				if !ok {
+					continue
+				}
+				if !def.hasComponent(referencedKey, referenced) {
+					return nil, fmt.Errorf("%s template %q references unknown %s template %q", kind.key, component["name"], referencedKey, referenced)
+				}
+			}
+		}
+	}
+	return def, nil
+}
+
+// hasComponent reports whether the definition lists a component of the given
+// kind with the given name.
+func (def *deviceTypeLibraryDefinition) hasComponent(key, name string) bool {
+	for _, component := range def.components[key] {
+		if component["name"] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// findManufacturerID returns the ID of the manufacturer with the given name
+// or slug.
+func (s *providerState) findManufacturerID(ctx context.Context, name string) (int64, error) {
+	for _, filter := range []string{"name", "slug"} {
+		results, err := s.listRaw(ctx, "dcim/manufacturers", url.Values{filter: {name}}, 0, 0)
+		if err != nil {
+			return 0, err
+		}
+		if len(results) == 1 {
+			return getRawID(results[0])
+		}
+	}
+	return 0, fmt.Errorf("no manufacturer named %q found", name)
+}
+
+func getDeviceTypeLibraryData(ctx context.Context, api *providerState, d *schema.ResourceData) (*deviceTypeLibraryDefinition, map[string]interface{}, error) {
+	def, err := parseDeviceTypeLibraryDefinition(d.Get("yaml").(string))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := map[string]interface{}{}
+	for k, v := range def.deviceType {
+		data[k] = v
+	}
+
+	// A manufacturer_id from the state might stem from a previous definition.
+	var manufacturerID int64
+	if !d.GetRawConfig().GetAttr("manufacturer_id").IsNull() {
+		manufacturerID = int64(d.Get("manufacturer_id").(int))
+	} else {
+		manufacturerID, err = api.findManufacturerID(ctx, def.manufacturer)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	data["manufacturer"] = manufacturerID
+
+	return def, data, nil
+}
+
+func resourceNetboxDeviceTypeFromLibraryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	def, data, err := getDeviceTypeLibraryData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result map[string]interface{}
+	if err := api.postRaw(ctx, "dcim/device-types", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	id, err := getRawID(result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+
+	if err := api.syncDeviceTypeLibraryComponents(ctx, id, def); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDeviceTypeFromLibraryRead(ctx, d, m)
+}
+
+func resourceNetboxDeviceTypeFromLibraryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var result struct {
+		Model        string  `json:"model"`
+		Slug         string  `json:"slug"`
+		PartNumber   string  `json:"part_number"`
+		UHeight      float64 `json:"u_height"`
+		Manufacturer struct {
+			ID int64 `json:"id"`
+		} `json:"manufacturer"`
+	}
+	if err := api.getRaw(ctx, fmt.Sprintf("dcim/device-types/%s", d.Id()), nil, &result); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("manufacturer_id", result.Manufacturer.ID)
+	d.Set("model", result.Model)
+	d.Set("slug", result.Slug)
+	d.Set("part_number", result.PartNumber)
+	d.Set("u_height", result.UHeight)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeFromLibraryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	def, data, err := getDeviceTypeLibraryData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("dcim/device-types/%d", id), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.syncDeviceTypeLibraryComponents(ctx, id, def); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDeviceTypeFromLibraryRead(ctx, d, m)
+}
+
+func resourceNetboxDeviceTypeFromLibraryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	// The component templates are deleted along with the device type.
+	if err := api.deleteRaw(ctx, fmt.Sprintf("dcim/device-types/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceNetboxDeviceTypeFromLibraryCustomizeDiff plans the attributes read
+// from the definition as unknown if it changes.
+func resourceNetboxDeviceTypeFromLibraryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("yaml") {
+		return nil
+	}
+	keys := []string{"model", "slug", "part_number", "u_height"}
+	if d.GetRawConfig().GetAttr("manufacturer_id").IsNull() {
+		keys = append(keys, "manufacturer_id")
+	}
+	for _, key := range keys {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listDeviceTypeLibraryComponents returns the IDs of the component templates
+// of a device type by kind and name.
+func (s *providerState) listDeviceTypeLibraryComponents(ctx context.Context, deviceTypeID int64) (map[string]map[string]int64, error) {
+	query := url.Values{"device_type_id": {strconv.FormatInt(deviceTypeID, 10)}}
+
+	existing := map[string]map[string]int64{}
+	for _, kind := range libraryComponentKinds {
+		results, err := s.listRaw(ctx, kind.path, query, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		existing[kind.key] = map[string]int64{}
+		for _, r := range results {
+			id, err := getRawID(r)
+			if err != nil {
+				return nil, err
+			}
+			existing[kind.key][fmt.Sprint(r["name"])] = id
+		}
+	}
+	return existing, nil
+}
+
+// syncDeviceTypeLibraryComponents makes the component templates of a device
+// type match the definition. Existing templates are matched by name.
+func (s *providerState) syncDeviceTypeLibraryComponents(ctx context.Context, deviceTypeID int64, def *deviceTypeLibraryDefinition) error {
+	existing, err := s.listDeviceTypeLibraryComponents(ctx, deviceTypeID)
+	if err != nil {
+		return err
+	}
+
+	// Templates are deleted in reverse order, so that no template is deleted
+	// while others still reference it.
+	deleted := false
+	for i := len(libraryComponentKinds) - 1; i >= 0; i-- {
+		kind := libraryComponentKinds[i]
+		wanted := map[string]bool{}
+		for _, component := range def.components[kind.key] {
+			wanted[component["name"].(string)] = true
+		}
+		for name, id := range existing[kind.key] {
+			if wanted[name] {
+				continue
+			}
+			if err := s.deleteRaw(ctx, fmt.Sprintf("%s/%d", kind.path, id)); err != nil && !isRawNotFound(err) {
+				return fmt.Errorf("error deleting %s template %q: %w", kind.key, name, err)
+			}
+			deleted = true
+		}
+	}
+
+	// Deleting a template may delete templates referencing it along with it,
+	// e.g. front port templates of a rear port template, even if they are
+	// still listed in the definition. Those are created again below.
+	if deleted {
+		existing, err = s.listDeviceTypeLibraryComponents(ctx, deviceTypeID)
+		if err != nil {
+			return err
+		}
+	}
+
+	manufacturerIDs := map[string]int64{}
+	for _, kind := range libraryComponentKinds {
+		for _, component := range def.components[kind.key] {
+			name := component["name"].(string)
+
+			data := map[string]interface{}{}
+			for k, v := range component {
+				data[k] = v
+			}
+			data["device_type"] = deviceTypeID
+
+			for attr, referencedKey := range kind.references {
+				referenced, ok := data[attr].(string)
+				if !ok {
+					continue
+				}
+				id, ok := existing[referencedKey][referenced]
+				if !ok {
+					return fmt.Errorf("%s template %q references unknown %s template %q", kind.key, name, referencedKey, referenced)
+				}
+				data[attr] = id
+			}
+			if manufacturer, ok := data["manufacturer"].(string); ok {
+				if _, ok := manufacturerIDs[manufacturer]; !ok {
+					id, err := s.findManufacturerID(ctx, manufacturer)
+					if err != nil {
+						return err
+					}
+					manufacturerIDs[manufacturer] = id
+				}
+				data["manufacturer"] = manufacturerIDs[manufacturer]
+			}
+
+			if id, ok := existing[kind.key][name]; ok {
+				if err := s.putRaw(ctx, fmt.Sprintf("%s/%d", kind.path, id), data, nil); err != nil {
+					return fmt.Errorf("error updating %s template %q: %w", kind.key, name, err)
+				}
+				continue
+			}
+
+			var result map[string]interface{}
+			if err := s.postRaw(ctx, kind.path, data, &result); err != nil {
+				return fmt.Errorf("error creating %s template %q: %w", kind.key, name, err)
+			}
+			id, err := getRawID(result)
+			if err != nil {
+				return err
+			}
+			existing[kind.key][name] = id
+		}
+	}
+	return nil
+}
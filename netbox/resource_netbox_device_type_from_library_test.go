@@ -0,0 +1,216 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDeviceTypeLibraryDefinition = `---
+manufacturer: %[1]s
+model: %[1]s
+slug: %[2]s
+part_number: %[1]s-PN
+u_height: 2
+is_full_depth: true
+front_image: true
+console-ports:
+  - name: con0
+    type: rj-45
+power-ports:
+  - name: PSU1
+    type: iec-60320-c14
+    maximum_draw: 300
+power-outlets:
+  - name: outlet1
+    type: iec-60320-c13
+    power_port: PSU1
+    feed_leg: A
+interfaces:
+  - name: eth0
+    type: 1000base-t
+    mgmt_only: true
+  - name: eth1
+    type: 10gbase-x-sfpp
+rear-ports:
+  - name: rear1
+    type: lc
+    positions: 2
+front-ports:
+  - name: front1
+    type: lc
+    rear_port: rear1
+    rear_port_position: 1
+module-bays:
+  - name: slot1
+    position: '1'
+`
+
+func TestParseDeviceTypeLibraryDefinition(t *testing.T) {
+	def, err := parseDeviceTypeLibraryDefinition(fmt.Sprintf(testDeviceTypeLibraryDefinition, "Vendor", "vendor-model"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Vendor", def.manufacturer)
+	assert.Equal(t, map[string]interface{}{
+		"model":         "Vendor",
+		"slug":          "vendor-model",
+		"part_number":   "Vendor-PN",
+		"u_height":      2,
+		"is_full_depth": true,
+	}, def.deviceType)
+	assert.Len(t, def.components["interfaces"], 2)
+	assert.Equal(t, "rear1", def.components["front-ports"][0]["rear_port"])
+	assert.NotContains(t, def.components, "device-bays")
+
+	def, err = parseDeviceTypeLibraryDefinition("manufacturer: Vendor\nmodel: Model One\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "model-one", def.deviceType["slug"])
+
+	for _, text := range []string{
+		"",
+		"model: Model",
+		"manufacturer: Vendor\nmodel: Model\ninterfaces: eth0",
+		"manufacturer: Vendor\nmodel: Model\ninterfaces:\n  - type: 1000base-t",
+		"manufacturer: Vendor\nmodel: Model\ninterfaces:\n  - name: eth0\n  - name: eth0",
+		"manufacturer: Vendor\nmodel: Model\nfront-ports:\n  - name: front1\n    rear_port: rear1",
+	} {
+		_, err := parseDeviceTypeLibraryDefinition(text)
+		assert.Error(t, err, text)
+	}
+}
+
+// testAccCheckDeviceTypeLibraryComponents checks that the component templates
+// of the given kind of a device type are exactly the expected ones. The
+// expected map holds the value of attr by template name. If ids is not nil,
+// the IDs of the templates are recorded in it on the first call and compared
+// on later calls, to check that templates are updated in place.
+func testAccCheckDeviceTypeLibraryComponents(n, path, attr string, expected map[string]string, ids map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		api := testAccProvider.Meta().(*providerState)
+		results, err := api.listRaw(context.Background(), path, url.Values{"device_type_id": {rs.Primary.ID}}, 0, 0)
+		if err != nil {
+			return err
+		}
+		if len(results) != len(expected) {
+			return fmt.Errorf("expected %d %s, got %d", len(expected), path, len(results))
+		}
+
+		for _, r := range results {
+			name := fmt.Sprint(r["name"])
+			want, ok := expected[name]
+			if !ok {
+				return fmt.Errorf("unexpected %s %q", path, name)
+			}
+
+			// choices and nested objects are returned as objects
+			value := r[attr]
+			if nested, ok := value.(map[string]interface{}); ok {
+				if v, ok := nested["value"]; ok {
+					value = v
+				} else {
+					value = nested["name"]
+				}
+			}
+			if fmt.Sprint(value) != want {
+				return fmt.Errorf("expected %s of %s %q to be %q, got %q", attr, path, name, want, value)
+			}
+
+			if ids != nil {
+				id := fmt.Sprint(r["id"])
+				if previous, ok := ids[name]; ok && previous != id {
+					return fmt.Errorf("expected %s %q to keep ID %s, got %s", path, name, previous, id)
+				}
+				ids[name] = id
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccNetboxDeviceTypeFromLibrary_basic(t *testing.T) {
+	testSlug := "dt_library"
+	testName := testAccGetTestName(testSlug)
+	interfaceIDs := map[string]string{}
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type_from_library" "test" {
+  yaml = <<-EOT
+%[2]s
+EOT
+
+  depends_on = [netbox_manufacturer.test]
+}`, testName, fmt.Sprintf(testDeviceTypeLibraryDefinition, testName, testName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type_from_library.test", "model", testName),
+					resource.TestCheckResourceAttr("netbox_device_type_from_library.test", "slug", testName),
+					resource.TestCheckResourceAttr("netbox_device_type_from_library.test", "part_number", testName+"-PN"),
+					resource.TestCheckResourceAttr("netbox_device_type_from_library.test", "u_height", "2"),
+					resource.TestCheckResourceAttrPair("netbox_device_type_from_library.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/console-port-templates", "type", map[string]string{"con0": "rj-45"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/power-port-templates", "maximum_draw", map[string]string{"PSU1": "300"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/power-outlet-templates", "power_port", map[string]string{"outlet1": "PSU1"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/interface-templates", "type", map[string]string{"eth0": "1000base-t", "eth1": "10gbase-x-sfpp"}, interfaceIDs),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/rear-port-templates", "positions", map[string]string{"rear1": "2"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/front-port-templates", "rear_port", map[string]string{"front1": "rear1"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/module-bay-templates", "position", map[string]string{"slot1": "1"}, nil),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type_from_library" "test" {
+  manufacturer_id = netbox_manufacturer.test.id
+  yaml = <<-EOT
+manufacturer: Some Vendor
+model: %[1]s
+slug: %[2]s
+u_height: 1
+interfaces:
+  - name: eth1
+    type: 25gbase-x-sfp28
+rear-ports:
+  - name: rear2
+    type: lc
+    positions: 4
+front-ports:
+  - name: front1
+    type: lc
+    rear_port: rear2
+    rear_port_position: 3
+EOT
+}`, testName, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type_from_library.test", "u_height", "1"),
+					resource.TestCheckResourceAttrPair("netbox_device_type_from_library.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/console-port-templates", "type", map[string]string{}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/power-port-templates", "type", map[string]string{}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/power-outlet-templates", "type", map[string]string{}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/interface-templates", "type", map[string]string{"eth1": "25gbase-x-sfp28"}, interfaceIDs),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/rear-port-templates", "positions", map[string]string{"rear2": "4"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/front-port-templates", "rear_port", map[string]string{"front1": "rear2"}, nil),
+					testAccCheckDeviceTypeLibraryComponents("netbox_device_type_from_library.test", "dcim/module-bay-templates", "position", map[string]string{}, nil),
+				),
+			},
+		},
+	})
+}
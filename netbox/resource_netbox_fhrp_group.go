@@ -0,0 +1,220 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxFhrpGroupProtocolOptions = []string{"vrrp2", "vrrp3", "carp", "clusterxl", "hsrp", "glbp", "other"}
+var resourceNetboxFhrpGroupAuthTypeOptions = []string{"plaintext", "md5"}
+
+// writableFhrpGroup is an FHRP group as sent to the API. Unlike the go-netbox
+// model, it always sends the optional attributes, so that removing them from
+// the configuration clears them.
+type writableFhrpGroup struct {
+	Protocol     string              `json:"protocol"`
+	GroupID      int64               `json:"group_id"`
+	Name         string              `json:"name"`
+	AuthType     string              `json:"auth_type"`
+	AuthKey      string              `json:"auth_key"`
+	Description  string              `json:"description"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxFhrpGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxFhrpGroupCreate,
+		ReadContext:   resourceNetboxFhrpGroupRead,
+		UpdateContext: resourceNetboxFhrpGroupUpdate,
+		DeleteContext: resourceNetboxFhrpGroupDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroup/):
+
+> A first-hop redundancy protocol (FHRP) enables multiple physical interfaces to present a virtual IP address (VIP) in a redundant manner. Examples of such protocols include VRRP, HSRP and GLBP. Each FHRP group is assigned to one or more device or virtual machine interfaces, and may have one or more virtual IP addresses assigned to it.`,
+
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFhrpGroupProtocolOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFhrpGroupProtocolOptions),
+			},
+			"group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "The group number of the FHRP group, e.g. the virtual router ID of a VRRP group.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFhrpGroupAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFhrpGroupAuthTypeOptions),
+			},
+			"auth_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_address_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the virtual IP addresses assigned to this FHRP group.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableFhrpGroup(api *providerState, d *schema.ResourceData) (*writableFhrpGroup, error) {
+	data := writableFhrpGroup{
+		Protocol:    d.Get("protocol").(string),
+		GroupID:     int64(d.Get("group_id").(int)),
+		Name:        d.Get("name").(string),
+		AuthType:    d.Get("auth_type").(string),
+		AuthKey:     d.Get("auth_key").(string),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxFhrpGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableFhrpGroup(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	if err := api.postRaw(ctx, "ipam/fhrp-groups", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxFhrpGroupRead(ctx, d, m)
+}
+
+func resourceNetboxFhrpGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	group := res.GetPayload()
+	d.Set("protocol", group.Protocol)
+	d.Set("group_id", group.GroupID)
+	d.Set("name", group.Name)
+	d.Set("auth_type", group.AuthType)
+	d.Set("auth_key", group.AuthKey)
+	d.Set("description", group.Description)
+	d.Set("comments", group.Comments)
+
+	ipAddressIDs := make([]int64, 0, len(group.IPAddresses))
+	for _, ipAddress := range group.IPAddresses {
+		ipAddressIDs = append(ipAddressIDs, ipAddress.ID)
+	}
+	d.Set("ip_address_ids", ipAddressIDs)
+
+	cf := api.getCustomFields(group.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, group.Tags)
+
+	return nil
+}
+
+func resourceNetboxFhrpGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableFhrpGroup(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("ipam/fhrp-groups/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxFhrpGroupRead(ctx, d, m)
+}
+
+func resourceNetboxFhrpGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxFhrpGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxFhrpGroupAssignmentCreate,
+		ReadContext:   resourceNetboxFhrpGroupAssignmentRead,
+		UpdateContext: resourceNetboxFhrpGroupAssignmentUpdate,
+		DeleteContext: resourceNetboxFhrpGroupAssignmentDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroupassignment/):
+
+> This model is used to apply an FHRP group to a router interface, and to assign a priority to that interface within the group.`,
+
+		Schema: map[string]*schema.Schema{
+			"fhrp_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"device_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_interface_id", "virtual_machine_interface_id"},
+			},
+			"virtual_machine_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_interface_id", "virtual_machine_interface_id"},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableFhrpGroupAssignment(d *schema.ResourceData) *models.WritableFHRPGroupAssignment {
+	data := models.WritableFHRPGroupAssignment{
+		Group:    int64ToPtr(int64(d.Get("fhrp_group_id").(int))),
+		Priority: int64ToPtr(int64(d.Get("priority").(int))),
+	}
+
+	deviceInterfaceID := getOptionalInt(d, "device_interface_id")
+	vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
+
+	switch {
+	case deviceInterfaceID != nil:
+		data.InterfaceType = strToPtr("dcim.interface")
+		data.InterfaceID = deviceInterfaceID
+	case vmInterfaceID != nil:
+		data.InterfaceType = strToPtr("virtualization.vminterface")
+		data.InterfaceID = vmInterfaceID
+	}
+
+	return &data
+}
+
+func resourceNetboxFhrpGroupAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsCreateParams().WithData(getWritableFhrpGroupAssignment(d))
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFhrpGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxFhrpGroupAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupAssignmentsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	assignment := res.GetPayload()
+	if assignment.Group != nil {
+		d.Set("fhrp_group_id", assignment.Group.ID)
+	}
+	d.Set("priority", assignment.Priority)
+
+	d.Set("device_interface_id", nil)
+	d.Set("virtual_machine_interface_id", nil)
+	if assignment.InterfaceType != nil {
+		switch *assignment.InterfaceType {
+		case "dcim.interface":
+			d.Set("device_interface_id", assignment.InterfaceID)
+		case "virtualization.vminterface":
+			d.Set("virtual_machine_interface_id", assignment.InterfaceID)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxFhrpGroupAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsUpdateParams().WithID(id).WithData(getWritableFhrpGroupAssignment(d))
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxFhrpGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxFhrpGroupAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupAssignmentsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
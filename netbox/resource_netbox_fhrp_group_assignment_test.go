@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFhrpGroupAssignment_basic(t *testing.T) {
+	testSlug := "fhrp_assign"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_fhrp_group" "test" {
+  protocol = "vrrp2"
+  group_id = 30
+}
+
+resource "netbox_device_interface" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+resource "netbox_fhrp_group_assignment" "test" {
+  fhrp_group_id       = netbox_fhrp_group.test.id
+  device_interface_id = netbox_device_interface.test.id
+  priority            = 100
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "fhrp_group_id", "netbox_fhrp_group.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "device_interface_id", "netbox_device_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "100"),
+				),
+			},
+			{
+				Config: setUp + `
+resource "netbox_fhrp_group_assignment" "test" {
+  fhrp_group_id       = netbox_fhrp_group.test.id
+  device_interface_id = netbox_device_interface.test.id
+  priority            = 200
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "200"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFhrpGroup_basic(t *testing.T) {
+	testSlug := "fhrp_group"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_fhrp_group" "test" {
+  protocol    = "vrrp3"
+  group_id    = 10
+  name        = "%[1]s"
+  auth_type   = "plaintext"
+  auth_key    = "supersecret"
+  description = "test"
+  comments    = "comment"
+  tags        = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "protocol", "vrrp3"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "group_id", "10"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_type", "plaintext"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_key", "supersecret"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "comments", "comment"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_fhrp_group" "test" {
+  protocol = "vrrp3"
+  group_id = 10
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "name", ""),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_type", ""),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_key", ""),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxFhrpGroup_virtualIP(t *testing.T) {
+	testSlug := "fhrp_group_vip"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_fhrp_group" "test" {
+  protocol = "hsrp"
+  group_id = 20
+  name     = "%[1]s"
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address   = "203.0.113.1/24"
+  status       = "active"
+  role         = "hsrp"
+  object_type  = "ipam.fhrpgroup"
+  interface_id = netbox_fhrp_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address.test", "object_type", "ipam.fhrpgroup"),
+					resource.TestCheckResourceAttrPair("netbox_ip_address.test", "interface_id", "netbox_fhrp_group.test", "id"),
+				),
+			},
+		},
+	})
+}
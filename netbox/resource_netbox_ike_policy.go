@@ -0,0 +1,219 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxIkePolicyModeOptions = []string{"aggressive", "main"}
+
+// ikePolicy is an IKE policy as returned by the API, see ikeProposal.
+type ikePolicy struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Version     *struct {
+		Value int64 `json:"value"`
+	} `json:"version"`
+	Mode *struct {
+		Value string `json:"value"`
+	} `json:"mode"`
+	Proposals []*struct {
+		ID int64 `json:"id"`
+	} `json:"proposals"`
+	PresharedKey string              `json:"preshared_key"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+type writableIkePolicy struct {
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	Version      int64               `json:"version"`
+	Mode         string              `json:"mode"`
+	Proposals    []int64             `json:"proposals"`
+	PresharedKey string              `json:"preshared_key"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxIkePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxIkePolicyCreate,
+		ReadContext:   resourceNetboxIkePolicyRead,
+		UpdateContext: resourceNetboxIkePolicyUpdate,
+		DeleteContext: resourceNetboxIkePolicyDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikepolicy/):
+
+> An IKE policy is a set of proposals used in IKE negotiation, and is referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+				Description:  "The IKE version. Valid values are `1` and `2`. Defaults to `2`.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIkePolicyModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIkePolicyModeOptions) + ". Only applies to IKEv1.",
+			},
+			"proposal_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"preshared_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The pre-shared key used for IKE authentication.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableIkePolicy(api *providerState, d *schema.ResourceData) (*writableIkePolicy, error) {
+	data := writableIkePolicy{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Version:      int64(d.Get("version").(int)),
+		Proposals:    toInt64List(d.Get("proposal_ids")),
+		Mode:         d.Get("mode").(string),
+		PresharedKey: d.Get("preshared_key").(string),
+		Comments:     d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxIkePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIkePolicy(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result ikePolicy
+	if err := api.postRaw(ctx, "vpn/ike-policies", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxIkePolicyRead(ctx, d, m)
+}
+
+func resourceNetboxIkePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var policy ikePolicy
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/ike-policies/%s", d.Id()), nil, &policy); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	if policy.Version != nil {
+		d.Set("version", policy.Version.Value)
+	}
+	if policy.Mode != nil {
+		d.Set("mode", policy.Mode.Value)
+	} else {
+		d.Set("mode", nil)
+	}
+	proposalIDs := make([]int64, 0, len(policy.Proposals))
+	for _, proposal := range policy.Proposals {
+		proposalIDs = append(proposalIDs, proposal.ID)
+	}
+	d.Set("proposal_ids", proposalIDs)
+	d.Set("preshared_key", policy.PresharedKey)
+	d.Set("comments", policy.Comments)
+
+	cf := api.getCustomFields(policy.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceNetboxIkePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIkePolicy(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/ike-policies/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIkePolicyRead(ctx, d, m)
+}
+
+func resourceNetboxIkePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/ike-policies/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIkePolicy_basic(t *testing.T) {
+	testSlug := "ike_policy"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-gcm"
+  group                 = 19
+}
+
+resource "netbox_ike_policy" "test" {
+  name          = "%[1]s"
+  version       = 1
+  mode          = "main"
+  proposal_ids  = [netbox_ike_proposal.test.id]
+  preshared_key = "supersecret"
+  description   = "test"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "version", "1"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "mode", "main"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_ike_policy.test", "proposal_ids.*", "netbox_ike_proposal.test", "id"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "preshared_key", "supersecret"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "description", "test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-gcm"
+  group                 = 19
+}
+
+resource "netbox_ike_policy" "test" {
+  name         = "%[1]s"
+  version      = 2
+  proposal_ids = [netbox_ike_proposal.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "version", "2"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "mode", ""),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "preshared_key", ""),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_ike_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,233 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxIkeProposalAuthenticationMethodOptions = []string{"preshared-keys", "certificates", "rsa-signatures", "dsa-signatures"}
+var resourceNetboxVpnEncryptionAlgorithmOptions = []string{"aes-128-cbc", "aes-128-gcm", "aes-192-cbc", "aes-192-gcm", "aes-256-cbc", "aes-256-gcm", "3des-cbc", "des-cbc"}
+var resourceNetboxVpnAuthenticationAlgorithmOptions = []string{"hmac-sha1", "hmac-sha256", "hmac-sha384", "hmac-sha512", "hmac-md5"}
+var resourceNetboxVpnDhGroupOptions = []int{1, 2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}
+
+// ikeProposal is an IKE proposal as returned by the API. go-netbox has
+// neither models nor client operations for the IKE and IPsec endpoints, so
+// these objects are managed through the raw API.
+type ikeProposal struct {
+	ID                   int64  `json:"id"`
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	AuthenticationMethod *struct {
+		Value string `json:"value"`
+	} `json:"authentication_method"`
+	EncryptionAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"encryption_algorithm"`
+	AuthenticationAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"authentication_algorithm"`
+	Group *struct {
+		Value int64 `json:"value"`
+	} `json:"group"`
+	SaLifetime   *int64              `json:"sa_lifetime"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+type writableIkeProposal struct {
+	Name                    string              `json:"name"`
+	Description             string              `json:"description"`
+	AuthenticationMethod    string              `json:"authentication_method"`
+	EncryptionAlgorithm     string              `json:"encryption_algorithm"`
+	AuthenticationAlgorithm string              `json:"authentication_algorithm"`
+	Group                   int64               `json:"group"`
+	SaLifetime              *int64              `json:"sa_lifetime"`
+	Comments                string              `json:"comments"`
+	Tags                    []*models.NestedTag `json:"tags"`
+	CustomFields            interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxIkeProposal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxIkeProposalCreate,
+		ReadContext:   resourceNetboxIkeProposalRead,
+		UpdateContext: resourceNetboxIkeProposalUpdate,
+		DeleteContext: resourceNetboxIkeProposalDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikeproposal/):
+
+> An IKE proposal defines a set of parameters used to establish a secure bidirectional connection across an untrusted medium, such as the Internet. IKE proposals defined in NetBox can be referenced by IKE policies, which can in turn be referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"authentication_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIkeProposalAuthenticationMethodOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIkeProposalAuthenticationMethodOptions),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnEncryptionAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnEncryptionAlgorithmOptions),
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationAlgorithmOptions),
+			},
+			"group": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxVpnDhGroupOptions),
+				Description:  "The Diffie-Hellman group number.",
+			},
+			"sa_lifetime": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The security association lifetime in seconds.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableIkeProposal(api *providerState, d *schema.ResourceData) (*writableIkeProposal, error) {
+	data := writableIkeProposal{
+		Name:                    d.Get("name").(string),
+		Description:             d.Get("description").(string),
+		AuthenticationMethod:    d.Get("authentication_method").(string),
+		EncryptionAlgorithm:     d.Get("encryption_algorithm").(string),
+		AuthenticationAlgorithm: d.Get("authentication_algorithm").(string),
+		Group:                   int64(d.Get("group").(int)),
+		SaLifetime:              getOptionalInt(d, "sa_lifetime"),
+		Comments:                d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxIkeProposalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIkeProposal(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result ikeProposal
+	if err := api.postRaw(ctx, "vpn/ike-proposals", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxIkeProposalRead(ctx, d, m)
+}
+
+func resourceNetboxIkeProposalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var proposal ikeProposal
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/ike-proposals/%s", d.Id()), nil, &proposal); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", proposal.Name)
+	d.Set("description", proposal.Description)
+	if proposal.AuthenticationMethod != nil {
+		d.Set("authentication_method", proposal.AuthenticationMethod.Value)
+	}
+	if proposal.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", proposal.EncryptionAlgorithm.Value)
+	}
+	if proposal.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", proposal.AuthenticationAlgorithm.Value)
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+	if proposal.Group != nil {
+		d.Set("group", proposal.Group.Value)
+	}
+	d.Set("sa_lifetime", proposal.SaLifetime)
+	d.Set("comments", proposal.Comments)
+
+	cf := api.getCustomFields(proposal.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, proposal.Tags)
+
+	return nil
+}
+
+func resourceNetboxIkeProposalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIkeProposal(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/ike-proposals/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIkeProposalRead(ctx, d, m)
+}
+
+func resourceNetboxIkeProposalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/ike-proposals/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
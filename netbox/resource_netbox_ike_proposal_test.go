@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIkeProposal_basic(t *testing.T) {
+	testSlug := "ike_proposal"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ike_proposal" "test" {
+  name                     = "%[1]s"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+  sa_lifetime              = 28800
+  description              = "test"
+  comments                 = "comment"
+  tags                     = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_method", "preshared-keys"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "encryption_algorithm", "aes-256-cbc"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "group", "14"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "sa_lifetime", "28800"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "comments", "comment"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-gcm"
+  group                 = 19
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "encryption_algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_algorithm", ""),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "group", "19"),
+					resource.TestCheckNoResourceAttr("netbox_ike_proposal.test", "sa_lifetime"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ike_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
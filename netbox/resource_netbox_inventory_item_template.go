@@ -0,0 +1,199 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxInventoryItemTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxInventoryItemTemplateCreate,
+		ReadContext:   resourceNetboxInventoryItemTemplateRead,
+		UpdateContext: resourceNetboxInventoryItemTemplateUpdate,
+		DeleteContext: resourceNetboxInventoryItemTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/inventoryitemtemplate/):
+
+> A template for an inventory item that will be automatically created when instantiating a new device. All attributes of this object will be copied to the new inventory item, including the associations with a parent item and assigned component, if any. See the inventory item documentation for more detail.
+
+Netbox only supports inventory item templates on device types, not on module types.`,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The parent inventory item template.",
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"part_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"component_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"dcim.powerporttemplate",
+					"dcim.poweroutlettemplate",
+					"dcim.frontporttemplate",
+					"dcim.rearporttemplate",
+					"dcim.consoleserverporttemplate",
+					"dcim.consoleporttemplate",
+					"dcim.interfacetemplate",
+				}, false),
+			},
+			"component_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"component_type"},
+				Description:  "The ID of the component template the inventory item is assigned to.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableInventoryItemTemplate(d *schema.ResourceData) *models.WritableInventoryItemTemplate {
+	data := models.WritableInventoryItemTemplate{
+		Name:         strToPtr(d.Get("name").(string)),
+		Description:  d.Get("description").(string),
+		Label:        d.Get("label").(string),
+		DeviceType:   int64ToPtr(int64(d.Get("device_type_id").(int))),
+		Parent:       getOptionalInt(d, "parent_id"),
+		Role:         getOptionalInt(d, "role_id"),
+		Manufacturer: getOptionalInt(d, "manufacturer_id"),
+		PartID:       d.Get("part_id").(string),
+		ComponentID:  getOptionalInt(d, "component_id"),
+	}
+
+	if componentType, ok := d.GetOk("component_type"); ok {
+		data.ComponentType = strToPtr(componentType.(string))
+	}
+
+	return &data
+}
+
+func resourceNetboxInventoryItemTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	params := dcim.NewDcimInventoryItemTemplatesCreateParams().WithData(getWritableInventoryItemTemplate(d))
+
+	res, err := api.Dcim.DcimInventoryItemTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxInventoryItemTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxInventoryItemTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimInventoryItemTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimInventoryItemTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimInventoryItemTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	d.Set("parent_id", tmpl.Parent)
+	if tmpl.Role != nil {
+		d.Set("role_id", tmpl.Role.ID)
+	} else {
+		d.Set("role_id", nil)
+	}
+	if tmpl.Manufacturer != nil {
+		d.Set("manufacturer_id", tmpl.Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
+	}
+	d.Set("part_id", tmpl.PartID)
+	d.Set("component_type", tmpl.ComponentType)
+	d.Set("component_id", tmpl.ComponentID)
+
+	return nil
+}
+
+func resourceNetboxInventoryItemTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemTemplatesUpdateParams().WithID(id).WithData(getWritableInventoryItemTemplate(d))
+
+	_, err := api.Dcim.DcimInventoryItemTemplatesUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxInventoryItemTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxInventoryItemTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInventoryItemTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimInventoryItemTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
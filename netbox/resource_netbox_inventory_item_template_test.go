@@ -0,0 +1,110 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestAccNetboxInventoryItemTemplate_basic(t *testing.T) {
+	testSlug := "inventory_item_template"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_inventory_item_role" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_interface_template" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  type = "10gbase-x-sfpp"
+}
+
+resource "netbox_inventory_item_template" "parent" {
+  name = "%[1]s_parent"
+  device_type_id = netbox_device_type.test.id
+}
+
+resource "netbox_inventory_item_template" "test" {
+  name = "%[1]s"
+  label = "%[1]s label"
+  description = "%[1]s description"
+  device_type_id = netbox_device_type.test.id
+  parent_id = netbox_inventory_item_template.parent.id
+  role_id = netbox_inventory_item_role.test.id
+  manufacturer_id = netbox_manufacturer.test.id
+  part_id = "SFP-10G-LR"
+  component_type = "dcim.interfacetemplate"
+  component_id = netbox_interface_template.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_inventory_item_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_inventory_item_template.test", "label", fmt.Sprintf("%s label", testName)),
+					resource.TestCheckResourceAttr("netbox_inventory_item_template.test", "description", fmt.Sprintf("%s description", testName)),
+					resource.TestCheckResourceAttr("netbox_inventory_item_template.test", "part_id", "SFP-10G-LR"),
+					resource.TestCheckResourceAttr("netbox_inventory_item_template.test", "component_type", "dcim.interfacetemplate"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item_template.test", "component_id", "netbox_interface_template.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item_template.test", "parent_id", "netbox_inventory_item_template.parent", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item_template.test", "role_id", "netbox_inventory_item_role.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item_template.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_inventory_item_template.test", "device_type_id", "netbox_device_type.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_inventory_item_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_inventory_item_template", &resource.Sweeper{
+		Name:         "netbox_inventory_item_template",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			params := dcim.NewDcimInventoryItemTemplatesListParams()
+			res, err := api.Dcim.DcimInventoryItemTemplatesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, tmpl := range res.GetPayload().Results {
+				if strings.HasPrefix(*tmpl.Name, testPrefix) {
+					deleteParams := dcim.NewDcimInventoryItemTemplatesDeleteParams().WithID(tmpl.ID)
+					_, err := api.Dcim.DcimInventoryItemTemplatesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted an inventory item template")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipsecPolicy is an IPsec policy as returned by the API, see ikeProposal.
+type ipsecPolicy struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Proposals   []*struct {
+		ID int64 `json:"id"`
+	} `json:"proposals"`
+	PfsGroup *struct {
+		Value int64 `json:"value"`
+	} `json:"pfs_group"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+type writableIpsecPolicy struct {
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	Proposals    []int64             `json:"proposals"`
+	PfsGroup     *int64              `json:"pfs_group"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxIpsecPolicyCreate,
+		ReadContext:   resourceNetboxIpsecPolicyRead,
+		UpdateContext: resourceNetboxIpsecPolicyUpdate,
+		DeleteContext: resourceNetboxIpsecPolicyDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecpolicy/):
+
+> An IPSec policy defines a set of proposals to be used in the formation of IPSec tunnels. A perfect forward secrecy (PFS) group may optionally also be designated.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"proposal_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"pfs_group": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxVpnDhGroupOptions),
+				Description:  "The Diffie-Hellman group used for perfect forward secrecy.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableIpsecPolicy(api *providerState, d *schema.ResourceData) (*writableIpsecPolicy, error) {
+	data := writableIpsecPolicy{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Proposals:   toInt64List(d.Get("proposal_ids")),
+		PfsGroup:    getOptionalInt(d, "pfs_group"),
+		Comments:    d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxIpsecPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIpsecPolicy(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result ipsecPolicy
+	if err := api.postRaw(ctx, "vpn/ipsec-policies", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxIpsecPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxIpsecPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var policy ipsecPolicy
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/ipsec-policies/%s", d.Id()), nil, &policy); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	proposalIDs := make([]int64, 0, len(policy.Proposals))
+	for _, proposal := range policy.Proposals {
+		proposalIDs = append(proposalIDs, proposal.ID)
+	}
+	d.Set("proposal_ids", proposalIDs)
+	if policy.PfsGroup != nil {
+		d.Set("pfs_group", policy.PfsGroup.Value)
+	} else {
+		d.Set("pfs_group", nil)
+	}
+	d.Set("comments", policy.Comments)
+
+	cf := api.getCustomFields(policy.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceNetboxIpsecPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIpsecPolicy(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/ipsec-policies/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpsecPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxIpsecPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/ipsec-policies/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
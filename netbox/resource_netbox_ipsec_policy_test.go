@@ -0,0 +1,61 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecPolicy_basic(t *testing.T) {
+	testSlug := "ipsec_policy"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_ipsec_proposal.test.id]
+  pfs_group    = 19
+  description  = "test"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_ipsec_policy.test", "proposal_ids.*", "netbox_ipsec_proposal.test", "id"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "pfs_group", "19"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "description", "test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_ipsec_proposal.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netbox_ipsec_policy.test", "pfs_group"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipsec_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
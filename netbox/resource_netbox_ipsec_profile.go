@@ -0,0 +1,199 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxIpsecProfileModeOptions = []string{"esp", "ah"}
+
+// ipsecProfile is an IPsec profile as returned by the API, see ikeProposal.
+type ipsecProfile struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Mode        *struct {
+		Value string `json:"value"`
+	} `json:"mode"`
+	IkePolicy *struct {
+		ID int64 `json:"id"`
+	} `json:"ike_policy"`
+	IpsecPolicy *struct {
+		ID int64 `json:"id"`
+	} `json:"ipsec_policy"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+type writableIpsecProfile struct {
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	Mode         string              `json:"mode"`
+	IkePolicy    int64               `json:"ike_policy"`
+	IpsecPolicy  int64               `json:"ipsec_policy"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxIpsecProfileCreate,
+		ReadContext:   resourceNetboxIpsecProfileRead,
+		UpdateContext: resourceNetboxIpsecProfileUpdate,
+		DeleteContext: resourceNetboxIpsecProfileDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecprofile/):
+
+> This object represents a unique set of IKE and IPSec policies for securing a tunnel.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIpsecProfileModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIpsecProfileModeOptions),
+			},
+			"ike_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ipsec_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableIpsecProfile(api *providerState, d *schema.ResourceData) (*writableIpsecProfile, error) {
+	data := writableIpsecProfile{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Mode:        d.Get("mode").(string),
+		IkePolicy:   int64(d.Get("ike_policy_id").(int)),
+		IpsecPolicy: int64(d.Get("ipsec_policy_id").(int)),
+		Comments:    d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxIpsecProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIpsecProfile(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result ipsecProfile
+	if err := api.postRaw(ctx, "vpn/ipsec-profiles", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxIpsecProfileRead(ctx, d, m)
+}
+
+func resourceNetboxIpsecProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var profile ipsecProfile
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/ipsec-profiles/%s", d.Id()), nil, &profile); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	if profile.Mode != nil {
+		d.Set("mode", profile.Mode.Value)
+	}
+	if profile.IkePolicy != nil {
+		d.Set("ike_policy_id", profile.IkePolicy.ID)
+	}
+	if profile.IpsecPolicy != nil {
+		d.Set("ipsec_policy_id", profile.IpsecPolicy.ID)
+	}
+	d.Set("comments", profile.Comments)
+
+	cf := api.getCustomFields(profile.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, profile.Tags)
+
+	return nil
+}
+
+func resourceNetboxIpsecProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIpsecProfile(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/ipsec-profiles/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpsecProfileRead(ctx, d, m)
+}
+
+func resourceNetboxIpsecProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/ipsec-profiles/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxIpsecProfileFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-gcm"
+  group                 = 19
+}
+
+resource "netbox_ike_policy" "test" {
+  name          = "%[1]s"
+  proposal_ids  = [netbox_ike_proposal.test.id]
+  preshared_key = "supersecret"
+}
+
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_ipsec_proposal.test.id]
+}
+`, testName)
+}
+
+func TestAccNetboxIpsecProfile_basic(t *testing.T) {
+	testSlug := "ipsec_profile"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.test.id
+  ipsec_policy_id = netbox_ipsec_policy.test.id
+  description     = "test"
+  tags            = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "mode", "esp"),
+					resource.TestCheckResourceAttrPair("netbox_ipsec_profile.test", "ike_policy_id", "netbox_ike_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_ipsec_profile.test", "ipsec_policy_id", "netbox_ipsec_policy.test", "id"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "tags.0", testName),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipsec_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,212 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipsecProposal is an IPsec proposal as returned by the API, see
+// ikeProposal.
+type ipsecProposal struct {
+	ID                  int64  `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	EncryptionAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"encryption_algorithm"`
+	AuthenticationAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"authentication_algorithm"`
+	SaLifetimeSeconds *int64              `json:"sa_lifetime_seconds"`
+	SaLifetimeData    *int64              `json:"sa_lifetime_data"`
+	Comments          string              `json:"comments"`
+	Tags              []*models.NestedTag `json:"tags"`
+	CustomFields      interface{}         `json:"custom_fields"`
+}
+
+type writableIpsecProposal struct {
+	Name                    string              `json:"name"`
+	Description             string              `json:"description"`
+	EncryptionAlgorithm     string              `json:"encryption_algorithm"`
+	AuthenticationAlgorithm string              `json:"authentication_algorithm"`
+	SaLifetimeSeconds       *int64              `json:"sa_lifetime_seconds"`
+	SaLifetimeData          *int64              `json:"sa_lifetime_data"`
+	Comments                string              `json:"comments"`
+	Tags                    []*models.NestedTag `json:"tags"`
+	CustomFields            interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxIpsecProposal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxIpsecProposalCreate,
+		ReadContext:   resourceNetboxIpsecProposalRead,
+		UpdateContext: resourceNetboxIpsecProposalUpdate,
+		DeleteContext: resourceNetboxIpsecProposalDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecproposal/):
+
+> An IPSec proposal defines a set of parameters used in negotiating security associations for IPSec tunnels. IPSec proposals defined in NetBox can be referenced by IPSec policies, which can in turn be referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"encryption_algorithm", "authentication_algorithm"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnEncryptionAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnEncryptionAlgorithmOptions),
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"encryption_algorithm", "authentication_algorithm"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationAlgorithmOptions),
+			},
+			"sa_lifetime_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The security association lifetime in seconds.",
+			},
+			"sa_lifetime_data": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The security association lifetime in kilobytes.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableIpsecProposal(api *providerState, d *schema.ResourceData) (*writableIpsecProposal, error) {
+	data := writableIpsecProposal{
+		Name:                    d.Get("name").(string),
+		Description:             d.Get("description").(string),
+		EncryptionAlgorithm:     d.Get("encryption_algorithm").(string),
+		AuthenticationAlgorithm: d.Get("authentication_algorithm").(string),
+		SaLifetimeSeconds:       getOptionalInt(d, "sa_lifetime_seconds"),
+		SaLifetimeData:          getOptionalInt(d, "sa_lifetime_data"),
+		Comments:                d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxIpsecProposalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIpsecProposal(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result ipsecProposal
+	if err := api.postRaw(ctx, "vpn/ipsec-proposals", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxIpsecProposalRead(ctx, d, m)
+}
+
+func resourceNetboxIpsecProposalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var proposal ipsecProposal
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/ipsec-proposals/%s", d.Id()), nil, &proposal); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", proposal.Name)
+	d.Set("description", proposal.Description)
+	if proposal.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", proposal.EncryptionAlgorithm.Value)
+	} else {
+		d.Set("encryption_algorithm", nil)
+	}
+	if proposal.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", proposal.AuthenticationAlgorithm.Value)
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+	d.Set("sa_lifetime_seconds", proposal.SaLifetimeSeconds)
+	d.Set("sa_lifetime_data", proposal.SaLifetimeData)
+	d.Set("comments", proposal.Comments)
+
+	cf := api.getCustomFields(proposal.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, proposal.Tags)
+
+	return nil
+}
+
+func resourceNetboxIpsecProposalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableIpsecProposal(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/ipsec-proposals/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpsecProposalRead(ctx, d, m)
+}
+
+func resourceNetboxIpsecProposalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/ipsec-proposals/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecProposal_basic(t *testing.T) {
+	testSlug := "ipsec_proposal"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                     = "%[1]s"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  sa_lifetime_seconds      = 3600
+  sa_lifetime_data         = 4608000
+  description              = "test"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "encryption_algorithm", "aes-256-cbc"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "authentication_algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_seconds", "3600"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_data", "4608000"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "description", "test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "encryption_algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "authentication_algorithm", ""),
+					resource.TestCheckNoResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_seconds"),
+					resource.TestCheckNoResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_data"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipsec_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
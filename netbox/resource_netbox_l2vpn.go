@@ -0,0 +1,249 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxL2vpnTypeOptions = []string{"vpws", "vpls", "vxlan", "vxlan-evpn", "mpls-evpn", "pbb-evpn", "evpn-vpws", "epl", "evpl", "ep-lan", "evp-lan", "ep-tree", "evp-tree", "spb"}
+
+// l2vpn is an L2VPN as returned by the API. go-netbox has models for L2VPNs,
+// but its client only knows the ipam/l2vpns endpoints, which Netbox 4 moved
+// to vpn/l2vpns, so the API is accessed directly.
+type l2vpn struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	Identifier *int64 `json:"identifier"`
+	Type       *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	ImportTargets []*models.NestedRouteTarget `json:"import_targets"`
+	ExportTargets []*models.NestedRouteTarget `json:"export_targets"`
+	Tenant        *models.NestedTenant        `json:"tenant"`
+	Description   string                      `json:"description"`
+	Comments      string                      `json:"comments"`
+	Tags          []*models.NestedTag         `json:"tags"`
+	CustomFields  interface{}                 `json:"custom_fields"`
+}
+
+type writableL2vpn struct {
+	Name          string              `json:"name"`
+	Slug          string              `json:"slug"`
+	Identifier    *int64              `json:"identifier"`
+	Type          string              `json:"type"`
+	ImportTargets []int64             `json:"import_targets"`
+	ExportTargets []int64             `json:"export_targets"`
+	Tenant        *int64              `json:"tenant"`
+	Description   string              `json:"description"`
+	Comments      string              `json:"comments"`
+	Tags          []*models.NestedTag `json:"tags"`
+	CustomFields  interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxL2vpn() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxL2vpnCreate,
+		ReadContext:   resourceNetboxL2vpnRead,
+		UpdateContext: resourceNetboxL2vpnUpdate,
+		DeleteContext: resourceNetboxL2vpnDelete,
+
+		Description: `:meta:subcategory:L2VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpn/):
+
+> A L2VPN object in NetBox is a representation of a layer 2 bridge technology such as VXLAN, VPLS, or EPL. Each L2VPN can be identified by name as well as by an optional unique identifier (VNI would be an example). Once created, L2VPNs can be terminated to interfaces and VLANs.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxL2vpnTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxL2vpnTypeOptions),
+			},
+			"identifier": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "A numeric identifier of the L2VPN, e.g. the VNI of a VXLAN.",
+			},
+			"import_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"export_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableL2vpn(api *providerState, d *schema.ResourceData) (*writableL2vpn, error) {
+	name := d.Get("name").(string)
+
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	data := writableL2vpn{
+		Name:          name,
+		Slug:          slug,
+		Identifier:    getOptionalInt(d, "identifier"),
+		Type:          d.Get("type").(string),
+		ImportTargets: toInt64List(d.Get("import_target_ids")),
+		ExportTargets: toInt64List(d.Get("export_target_ids")),
+		Tenant:        getOptionalInt(d, "tenant_id"),
+		Description:   d.Get("description").(string),
+		Comments:      d.Get("comments").(string),
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxL2vpnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableL2vpn(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result l2vpn
+	if err := api.postRaw(ctx, "vpn/l2vpns", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxL2vpnRead(ctx, d, m)
+}
+
+func resourceNetboxL2vpnRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var vpn l2vpn
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/l2vpns/%s", d.Id()), nil, &vpn); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", vpn.Name)
+	d.Set("slug", vpn.Slug)
+	d.Set("identifier", vpn.Identifier)
+	if vpn.Type != nil {
+		d.Set("type", vpn.Type.Value)
+	}
+	d.Set("import_target_ids", getIDsFromNestedRouteTargets(vpn.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTargets(vpn.ExportTargets))
+	if vpn.Tenant != nil {
+		d.Set("tenant_id", vpn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", vpn.Description)
+	d.Set("comments", vpn.Comments)
+
+	cf := api.getCustomFields(vpn.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, vpn.Tags)
+
+	return nil
+}
+
+func getIDsFromNestedRouteTargets(targets []*models.NestedRouteTarget) []int64 {
+	ids := make([]int64, 0, len(targets))
+	for _, target := range targets {
+		ids = append(ids, target.ID)
+	}
+	return ids
+}
+
+func resourceNetboxL2vpnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableL2vpn(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/l2vpns/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxL2vpnRead(ctx, d, m)
+}
+
+func resourceNetboxL2vpnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/l2vpns/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
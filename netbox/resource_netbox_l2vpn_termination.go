@@ -0,0 +1,194 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// l2vpnTermination is an L2VPN termination as returned by the API, see l2vpn
+// for why the go-netbox client is not used.
+type l2vpnTermination struct {
+	ID    int64 `json:"id"`
+	L2vpn *struct {
+		ID int64 `json:"id"`
+	} `json:"l2vpn"`
+	AssignedObjectType string              `json:"assigned_object_type"`
+	AssignedObjectID   int64               `json:"assigned_object_id"`
+	Tags               []*models.NestedTag `json:"tags"`
+	CustomFields       interface{}         `json:"custom_fields"`
+}
+
+type writableL2vpnTermination struct {
+	L2vpn              int64               `json:"l2vpn"`
+	AssignedObjectType string              `json:"assigned_object_type"`
+	AssignedObjectID   int64               `json:"assigned_object_id"`
+	Tags               []*models.NestedTag `json:"tags"`
+	CustomFields       interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxL2vpnTermination() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxL2vpnTerminationCreate,
+		ReadContext:   resourceNetboxL2vpnTerminationRead,
+		UpdateContext: resourceNetboxL2vpnTerminationUpdate,
+		DeleteContext: resourceNetboxL2vpnTerminationDelete,
+
+		Description: `:meta:subcategory:L2VPN:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpntermination/):
+
+> A L2VPN termination is the attachment of an L2VPN to an interface or VLAN. Note that the L2VPNs of the following types may have only two terminations assigned to them: VPWS, EPL, EP-LAN, EP-TREE.`,
+
+		Schema: map[string]*schema.Schema{
+			"l2vpn_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"vlan_id", "device_interface_id", "virtual_machine_interface_id"},
+			},
+			"device_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"vlan_id", "device_interface_id", "virtual_machine_interface_id"},
+			},
+			"virtual_machine_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"vlan_id", "device_interface_id", "virtual_machine_interface_id"},
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableL2vpnTermination(api *providerState, d *schema.ResourceData) (*writableL2vpnTermination, error) {
+	data := writableL2vpnTermination{
+		L2vpn: int64(d.Get("l2vpn_id").(int)),
+	}
+
+	vlanID := getOptionalInt(d, "vlan_id")
+	deviceInterfaceID := getOptionalInt(d, "device_interface_id")
+	vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
+
+	switch {
+	case vlanID != nil:
+		data.AssignedObjectType = "ipam.vlan"
+		data.AssignedObjectID = *vlanID
+	case deviceInterfaceID != nil:
+		data.AssignedObjectType = "dcim.interface"
+		data.AssignedObjectID = *deviceInterfaceID
+	case vmInterfaceID != nil:
+		data.AssignedObjectType = "virtualization.vminterface"
+		data.AssignedObjectID = *vmInterfaceID
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxL2vpnTerminationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableL2vpnTermination(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result l2vpnTermination
+	if err := api.postRaw(ctx, "vpn/l2vpn-terminations", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxL2vpnTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxL2vpnTerminationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var termination l2vpnTermination
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/l2vpn-terminations/%s", d.Id()), nil, &termination); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if termination.L2vpn != nil {
+		d.Set("l2vpn_id", termination.L2vpn.ID)
+	}
+
+	d.Set("vlan_id", nil)
+	d.Set("device_interface_id", nil)
+	d.Set("virtual_machine_interface_id", nil)
+
+	switch termination.AssignedObjectType {
+	case "ipam.vlan":
+		d.Set("vlan_id", termination.AssignedObjectID)
+	case "dcim.interface":
+		d.Set("device_interface_id", termination.AssignedObjectID)
+	case "virtualization.vminterface":
+		d.Set("virtual_machine_interface_id", termination.AssignedObjectID)
+	}
+
+	cf := api.getCustomFields(termination.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, termination.Tags)
+
+	return nil
+}
+
+func resourceNetboxL2vpnTerminationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableL2vpnTermination(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/l2vpn-terminations/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxL2vpnTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxL2vpnTerminationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/l2vpn-terminations/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpnTermination_basic(t *testing.T) {
+	testSlug := "l2vpn_term"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name = "%[1]s"
+  type = "vxlan"
+}
+
+resource "netbox_device_interface" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id = netbox_l2vpn.test.id
+  vlan_id  = netbox_vlan.test1.id
+  tags     = ["%[1]s"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "l2vpn_id", "netbox_l2vpn.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "vlan_id", "netbox_vlan.test1", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "device_interface_id", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: setUp + `
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id            = netbox_l2vpn.test.id
+  device_interface_id = netbox_device_interface.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "device_interface_id", "netbox_device_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "vlan_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn_termination.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpn_basic(t *testing.T) {
+	testSlug := "l2"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_route_target" "import" {
+  name = "%[1]si"
+}
+
+resource "netbox_route_target" "export" {
+  name = "%[1]se"
+}
+
+resource "netbox_l2vpn" "test" {
+  name              = "%[1]s"
+  type              = "vxlan-evpn"
+  identifier        = 10100
+  import_target_ids = [netbox_route_target.import.id]
+  export_target_ids = [netbox_route_target.export.id]
+  tenant_id         = netbox_tenant.test.id
+  description       = "test"
+  comments          = "comment"
+  tags              = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vxlan-evpn"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "10100"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "import_target_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_l2vpn.test", "import_target_ids.*", "netbox_route_target.import", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "export_target_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_l2vpn.test", "export_target_ids.*", "netbox_route_target.export", "id"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "comments", "comment"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tags.0", testName+"a"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name = "%[1]s"
+  type = "vpls"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vpls"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "import_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "export_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tenant_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -108,9 +108,12 @@ func resourceNetboxMACAddressCreate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimMacAddressesCreateParams().WithData(&data)
@@ -171,7 +174,7 @@ func resourceNetboxMACAddressRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("comments", macAddress.Comments)
 	api.readTags(d, macAddress.Tags)
 
-	cf := getCustomFields(macAddress.CustomFields)
+	cf := api.getCustomFields(macAddress.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -218,9 +221,12 @@ func resourceNetboxMACAddressUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimMacAddressesPartialUpdateParams().WithID(id).WithData(&data)
@@ -0,0 +1,158 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// moduleBayTemplate is a module bay template as returned by the API. The
+// go-netbox models lack the module type of module bay templates.
+type moduleBayTemplate struct {
+	ID          int64                    `json:"id"`
+	Name        string                   `json:"name"`
+	Label       string                   `json:"label"`
+	Position    string                   `json:"position"`
+	Description string                   `json:"description"`
+	DeviceType  *models.NestedDeviceType `json:"device_type"`
+	ModuleType  *models.NestedModuleType `json:"module_type"`
+}
+
+type writableModuleBayTemplate struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Position    string `json:"position"`
+	Description string `json:"description"`
+	DeviceType  *int64 `json:"device_type"`
+	ModuleType  *int64 `json:"module_type"`
+}
+
+func resourceNetboxModuleBayTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxModuleBayTemplateCreate,
+		ReadContext:   resourceNetboxModuleBayTemplateRead,
+		UpdateContext: resourceNetboxModuleBayTemplateUpdate,
+		DeleteContext: resourceNetboxModuleBayTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/modulebaytemplate/):
+
+> A template for a module bay that will be created on all instantiations of the parent device type. See the module bay documentation for more detail.`,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"position": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 30),
+				Description:  "The position of the module bay within the device type. Modules installed in the bay can reference it with `{module}` in the names of their components.",
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableModuleBayTemplate(d *schema.ResourceData) *writableModuleBayTemplate {
+	return &writableModuleBayTemplate{
+		Name:        d.Get("name").(string),
+		Label:       d.Get("label").(string),
+		Position:    d.Get("position").(string),
+		Description: d.Get("description").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+}
+
+func resourceNetboxModuleBayTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var result moduleBayTemplate
+	if err := api.postRaw(ctx, "dcim/module-bay-templates", getWritableModuleBayTemplate(d), &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxModuleBayTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxModuleBayTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var tmpl moduleBayTemplate
+	if err := api.getRaw(ctx, fmt.Sprintf("dcim/module-bay-templates/%s", d.Id()), nil, &tmpl); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+	d.Set("position", tmpl.Position)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	return nil
+}
+
+func resourceNetboxModuleBayTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var result moduleBayTemplate
+	if err := api.putRaw(ctx, fmt.Sprintf("dcim/module-bay-templates/%s", d.Id()), getWritableModuleBayTemplate(d), &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxModuleBayTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxModuleBayTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("dcim/module-bay-templates/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -80,9 +80,12 @@ func resourceNetboxModuleTypeCreate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimModuleTypesCreateParams().WithData(&data)
@@ -135,7 +138,7 @@ func resourceNetboxModuleTypeRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", moduleType.Description)
 	d.Set("comments", moduleType.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -165,9 +168,12 @@ func resourceNetboxModuleTypeUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimModuleTypesPartialUpdateParams().WithID(id).WithData(&data)
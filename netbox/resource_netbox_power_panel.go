@@ -66,9 +66,12 @@ func resourceNetboxPowerPanelCreate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimPowerPanelsCreateParams().WithData(&data)
@@ -119,7 +122,7 @@ func resourceNetboxPowerPanelRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", powerPanel.Description)
 	d.Set("comments", powerPanel.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -147,9 +150,12 @@ func resourceNetboxPowerPanelUpdate(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimPowerPanelsPartialUpdateParams().WithID(id).WithData(&data)
@@ -1,7 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -87,9 +89,7 @@ func resourceNetboxPrefix() *schema.Resource {
 			customFieldsKey: customFieldsSchema,
 			tagsKey:         tagsSchema,
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: naturalKeyImporter(resolveNetboxPrefixImportKey),
 	}
 }
 func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
@@ -149,9 +149,12 @@ func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
 		data.ScopeID = nil
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	var err error
@@ -241,12 +244,13 @@ func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 			d.Set("region_id", scopeID)
 		}
 	}
-	cf := getCustomFields(prefix.CustomFields)
+	cf := api.getCustomFields(prefix.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
 	api.readTags(d, prefix.Tags)
+	stripAllocationKey(d)
 	// FIGURE OUT NESTED VRF AND NESTED VLAN (from maybe interfaces?)
 
 	return nil
@@ -290,7 +294,11 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if cf, ok := d.GetOk(customFieldsKey); ok {
-		data.CustomFields = cf
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	siteID := getOptionalInt(d, "site_id")
@@ -321,6 +329,9 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
+	if err := api.setAllocationKey(d, &data.Description, &data.Tags, &data.CustomFields); err != nil {
+		return err
+	}
 
 	params := ipam.NewIpamPrefixesUpdateParams().WithID(id).WithData(&data)
 	_, err = api.Ipam.IpamPrefixesUpdate(params, nil)
@@ -347,3 +358,43 @@ func resourceNetboxPrefixDelete(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// resolveNetboxPrefixImportKey resolves a key of the form `vrf-name/prefix` or
+// `prefix` (for prefixes without VRF) to the ID of the prefix.
+func resolveNetboxPrefixImportKey(api *providerState, key string) (int64, error) {
+	params := ipam.NewIpamPrefixesListParams()
+
+	i := strings.LastIndex(key, "/")
+	if i <= 0 {
+		return 0, fmt.Errorf("invalid import key %q, expected the numeric ID, <vrf>/<prefix> or <prefix>", key)
+	}
+	prefix := key
+	vrfID := "null"
+	if j := strings.LastIndex(key[:i], "/"); j >= 0 {
+		vrf := key[:j]
+		prefix = key[j+1:]
+
+		vrfParams := ipam.NewIpamVrfsListParams().WithName(&vrf)
+		vrfRes, err := api.Ipam.IpamVrfsList(vrfParams, nil)
+		if err != nil {
+			return 0, err
+		}
+		id, err := getSingleImportResult("vrf", vrf, *vrfRes.GetPayload().Count, func() int64 {
+			return vrfRes.GetPayload().Results[0].ID
+		})
+		if err != nil {
+			return 0, err
+		}
+		vrfID = strconv.FormatInt(id, 10)
+	}
+	params.Prefix = &prefix
+	params.VrfID = &vrfID
+
+	res, err := api.Ipam.IpamPrefixesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	return getSingleImportResult("prefix", key, *res.GetPayload().Count, func() int64 {
+		return res.GetPayload().Results[0].ID
+	})
+}
@@ -229,6 +229,12 @@ resource "netbox_prefix" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "netbox_prefix.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testPrefix,
+			},
 		},
 	})
 }
@@ -201,9 +201,12 @@ func resourceNetboxRackCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimRacksCreateParams().WithData(&data)
@@ -313,7 +316,7 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("form_factor", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -375,9 +378,12 @@ func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimRacksPartialUpdateParams().WithID(id).WithData(&data)
@@ -0,0 +1,172 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxRearPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxRearPortTemplateCreate,
+		ReadContext:   resourceNetboxRearPortTemplateRead,
+		UpdateContext: resourceNetboxRearPortTemplateUpdate,
+		DeleteContext: resourceNetboxRearPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/rearporttemplate/):
+
+> A template for a rear-facing pass-through port that will be created on all instantiations of the parent device type. See the rear port documentation for more detail.`,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "One of [8p8c, 8p6c, 8p4c, 8p2c, 6p6c, 6p4c, 6p2c, 4p4c, 4p2c, gg45, tera-4p, tera-2p, tera-1p, 110-punch, bnc, f, n, mrj21, fc, lc, lc-pc, lc-upc, lc-apc, lsh, lsh-pc, lsh-upc, lsh-apc, mpo, mtrj, sc, sc-pc, sc-upc, sc-apc, st, cs, sn, sma-905, sma-906, urm-p2, urm-p4, urm-p8, splice, other]",
+			},
+			"positions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1024),
+				Description:  "The number of front ports which may be mapped to the rear port.",
+			},
+			"color_hex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableRearPortTemplate(d *schema.ResourceData) *models.WritableRearPortTemplate {
+	return &models.WritableRearPortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Type:        strToPtr(d.Get("type").(string)),
+		Positions:   int64(d.Get("positions").(int)),
+		Color:       d.Get("color_hex").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+}
+
+func resourceNetboxRearPortTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	params := dcim.NewDcimRearPortTemplatesCreateParams().WithData(getWritableRearPortTemplate(d))
+
+	res, err := api.Dcim.DcimRearPortTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxRearPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxRearPortTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimRearPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimRearPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimRearPortTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+	if tmpl.Type != nil {
+		d.Set("type", tmpl.Type.Value)
+	}
+	d.Set("positions", tmpl.Positions)
+	d.Set("color_hex", tmpl.Color)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	return nil
+}
+
+func resourceNetboxRearPortTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimRearPortTemplatesUpdateParams().WithID(id).WithData(getWritableRearPortTemplate(d))
+
+	_, err := api.Dcim.DcimRearPortTemplatesUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxRearPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxRearPortTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimRearPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimRearPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimRearPortTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
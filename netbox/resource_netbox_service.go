@@ -126,9 +126,12 @@ func resourceNetboxServiceCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Ipaddresses = []int64{}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := ipam.NewIpamServicesCreateParams().WithData(&data)
@@ -178,7 +181,7 @@ func resourceNetboxServiceRead(d *schema.ResourceData, m interface{}) error {
 		api.readTags(d, tags)
 	}
 
-	cf := getCustomFields(service.CustomFields)
+	cf := api.getCustomFields(service.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -232,9 +235,12 @@ func resourceNetboxServiceUpdate(d *schema.ResourceData, m interface{}) error {
 		data.ParentObjectID = deviceID
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := ipam.NewIpamServicesUpdateParams().WithID(id).WithData(&data)
@@ -100,9 +100,7 @@ func resourceNetboxSite() *schema.Resource {
 			},
 			customFieldsKey: customFieldsSchema,
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: naturalKeyImporter(resolveNetboxSiteImportKey),
 	}
 }
 
@@ -186,9 +184,12 @@ func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimSitesCreateParams().WithData(&data)
@@ -255,7 +256,7 @@ func resourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := api.getCustomFields(res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -357,9 +358,12 @@ func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return err
+		}
+		data.CustomFields = customFields
 	}
 
 	params := dcim.NewDcimSitesPartialUpdateParams().WithID(id).WithData(&data)
@@ -398,3 +402,15 @@ func getIDsFromNestedASNList(nestedASNs []*models.NestedASN) []int64 {
 	}
 	return asns
 }
+
+// resolveNetboxSiteImportKey resolves the slug of a site to its ID.
+func resolveNetboxSiteImportKey(api *providerState, key string) (int64, error) {
+	params := dcim.NewDcimSitesListParams().WithSlug(&key)
+	res, err := api.Dcim.DcimSitesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	return getSingleImportResult("site", key, *res.GetPayload().Count, func() int64 {
+		return res.GetPayload().Results[0].ID
+	})
+}
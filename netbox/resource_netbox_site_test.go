@@ -3,6 +3,7 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -63,6 +64,12 @@ resource "netbox_site" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "netbox_site.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     randomSlug,
+			},
 		},
 	})
 }
@@ -102,6 +109,84 @@ resource "netbox_site" "test" {
 	})
 }
 
+func TestAccNetboxSite_typedCustomFields(t *testing.T) {
+	testSlug := "site_typed_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "integer" {
+	name          = "%[1]s_int"
+	type          = "integer"
+	content_types = ["dcim.site"]
+}
+resource "netbox_custom_field" "boolean" {
+	name          = "%[1]s_bool"
+	type          = "boolean"
+	content_types = ["dcim.site"]
+}
+resource "netbox_custom_field" "json" {
+	name          = "%[1]s_json"
+	type          = "json"
+	content_types = ["dcim.site"]
+}
+resource "netbox_site" "test" {
+  name          = "%[2]s"
+  custom_fields = {
+    "${netbox_custom_field.integer.name}" = "42"
+    "${netbox_custom_field.boolean.name}" = "true"
+    "${netbox_custom_field.json.name}"    = jsonencode({ foo = ["bar", "baz"] })
+  }
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_int", "42"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_bool", "true"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_json", `{"foo":["bar","baz"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxSite_invalidCustomFields(t *testing.T) {
+	testSlug := "site_invalid_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name          = "%[2]s"
+  custom_fields = {"%[1]s_missing" = "foo"}
+}`, testField, testName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("custom field \"" + testField + "_missing\" does not exist"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+	name               = "%[1]s"
+	type               = "integer"
+	content_types      = ["dcim.site"]
+	validation_maximum = 10
+}
+resource "netbox_site" "test" {
+  name          = "%[2]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "11"}
+}`, testField, testName),
+				ExpectError: regexp.MustCompile("greater than the maximum of 10"),
+			},
+		},
+	})
+}
+
 func TestAccNetboxSite_fieldUpdate(t *testing.T) {
 	testSlug := "site_field_update"
 	testName := testAccGetTestName(testSlug)
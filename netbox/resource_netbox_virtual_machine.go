@@ -202,9 +202,12 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	data.Tags = tags
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data.CustomFields = customFields
 	}
 
 	params := virtualization.NewVirtualizationVirtualMachinesCreateParams().WithData(&data)
@@ -324,7 +327,7 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	}
 	api.readTags(d, vm.Tags)
 
-	cf := getCustomFields(vm.CustomFields)
+	cf := api.getCustomFields(vm.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -422,9 +425,12 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	data.Tags = tags
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		customFields, err := api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data.CustomFields = customFields
 	}
 
 	if d.HasChanges("comments") {
@@ -1,7 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
@@ -63,9 +65,7 @@ func resourceNetboxVlan() *schema.Resource {
 			},
 			tagsKey: tagsSchema,
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: naturalKeyImporter(resolveNetboxVlanImportKey),
 	}
 }
 
@@ -220,3 +220,43 @@ func resourceNetboxVlanDelete(d *schema.ResourceData, m interface{}) error {
 
 	return nil
 }
+
+// resolveNetboxVlanImportKey resolves a key of the form `group/vid` to the ID
+// of the VLAN. The group may be given by its slug or name.
+func resolveNetboxVlanImportKey(api *providerState, key string) (int64, error) {
+	group, vid, found := strings.Cut(key, "/")
+	if !found {
+		return 0, fmt.Errorf("invalid import key %q, expected the numeric ID or <group>/<vid>", key)
+	}
+	if _, err := strconv.Atoi(vid); err != nil {
+		return 0, fmt.Errorf("invalid import key %q, %q is not a valid VLAN ID", key, vid)
+	}
+
+	groupParams := ipam.NewIpamVlanGroupsListParams().WithSlug(&group)
+	groupRes, err := api.Ipam.IpamVlanGroupsList(groupParams, nil)
+	if err != nil {
+		return 0, err
+	}
+	if *groupRes.GetPayload().Count == 0 {
+		groupParams = ipam.NewIpamVlanGroupsListParams().WithName(&group)
+		groupRes, err = api.Ipam.IpamVlanGroupsList(groupParams, nil)
+		if err != nil {
+			return 0, err
+		}
+	}
+	groupID, err := getSingleImportResult("vlan group", group, *groupRes.GetPayload().Count, func() int64 {
+		return groupRes.GetPayload().Results[0].ID
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	params := ipam.NewIpamVlansListParams().WithGroupID(strToPtr(strconv.FormatInt(groupID, 10))).WithVid(&vid)
+	res, err := api.Ipam.IpamVlansList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+	return getSingleImportResult("vlan", key, *res.GetPayload().Count, func() int64 {
+		return res.GetPayload().Results[0].ID
+	})
+}
@@ -104,6 +104,12 @@ resource "netbox_vlan" "test_with_dependencies" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "netbox_vlan.test_with_dependencies",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFromAttributes("%s/%s", "netbox_vlan_group.test_group.slug", "netbox_vlan.test_with_dependencies.vid"),
+			},
 		},
 	})
 }
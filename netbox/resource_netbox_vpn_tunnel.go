@@ -1,22 +1,55 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var resourceNetboxVpnTunnelEncapsulationOptions = []string{"ipsec-transport", "ipsec-tunnel", "ip-ip", "gre"}
 var resourceNetboxVpnTunnelStatusOptions = []string{"planned", "active", "disabled"}
 
+// vpnTunnel is a tunnel as returned by the API. The go-netbox models lack the
+// IPsec profile of tunnels.
+type vpnTunnel struct {
+	ID            int64                       `json:"id"`
+	Name          string                      `json:"name"`
+	Encapsulation *models.TunnelEncapsulation `json:"encapsulation"`
+	Status        *models.TunnelStatus        `json:"status"`
+	Group         *models.NestedTunnelGroup   `json:"group"`
+	Tenant        *models.NestedTenant        `json:"tenant"`
+	TunnelID      *int64                      `json:"tunnel_id"`
+	IpsecProfile  *struct {
+		ID int64 `json:"id"`
+	} `json:"ipsec_profile"`
+	Description string              `json:"description"`
+	Tags        []*models.NestedTag `json:"tags"`
+}
+
+// writableVpnTunnel is a tunnel as sent to the API. The optional attributes
+// are always sent, so that removing them from the configuration clears them.
+type writableVpnTunnel struct {
+	Name          string              `json:"name"`
+	Encapsulation string              `json:"encapsulation"`
+	Status        string              `json:"status"`
+	Group         int64               `json:"group"`
+	Description   string              `json:"description"`
+	Tenant        *int64              `json:"tenant"`
+	TunnelID      *int64              `json:"tunnel_id"`
+	IpsecProfile  *int64              `json:"ipsec_profile"`
+	Tags          []*models.NestedTag `json:"tags"`
+}
+
 func resourceNetboxVpnTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxVpnTunnelCreate,
-		Read:   resourceNetboxVpnTunnelRead,
-		Update: resourceNetboxVpnTunnelUpdate,
-		Delete: resourceNetboxVpnTunnelDelete,
+		CreateContext: resourceNetboxVpnTunnelCreate,
+		ReadContext:   resourceNetboxVpnTunnelRead,
+		UpdateContext: resourceNetboxVpnTunnelUpdate,
+		DeleteContext: resourceNetboxVpnTunnelDelete,
 
 		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/features/vpn-tunnels/):
 
@@ -53,6 +86,10 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"ipsec_profile_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			tagsKey: tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
@@ -61,58 +98,65 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 	}
 }
 
-func resourceNetboxVpnTunnelCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*providerState)
-
-	data := models.WritableTunnel{}
-
-	data.Name = strToPtr(d.Get("name").(string))
-	data.Encapsulation = strToPtr(d.Get("encapsulation").(string))
-	data.Status = strToPtr(d.Get("status").(string))
-	data.Group = int64ToPtr(int64(d.Get("tunnel_group_id").(int)))
+func getWritableVpnTunnel(api *providerState, d *schema.ResourceData) (*writableVpnTunnel, error) {
+	data := writableVpnTunnel{
+		Name:          d.Get("name").(string),
+		Encapsulation: d.Get("encapsulation").(string),
+		Status:        d.Get("status").(string),
+		Group:         int64(d.Get("tunnel_group_id").(int)),
+		Description:   d.Get("description").(string),
+		Tenant:        getOptionalInt(d, "tenant_id"),
+		TunnelID:      getOptionalInt(d, "tunnel_id"),
+		IpsecProfile:  getOptionalInt(d, "ipsec_profile_id"),
+	}
 
-	data.Description = getOptionalStr(d, "description", false)
-	data.Tenant = getOptionalInt(d, "tenant_id")
-	data.TunnelID = getOptionalInt(d, "tunnel_id")
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
-	data.Tags = tags
+	return &data, nil
+}
 
-	params := vpn.NewVpnTunnelsCreateParams().WithData(&data)
+func resourceNetboxVpnTunnelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
 
-	res, err := api.Vpn.VpnTunnelsCreate(params, nil)
+	data, err := getWritableVpnTunnel(api, d)
 	if err != nil {
-		//return errors.New(getTextFromError(err))
-		return err
+		return diag.FromErr(err)
+	}
+
+	var result vpnTunnel
+	if err := api.postRaw(ctx, "vpn/tunnels", data, &result); err != nil {
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	d.SetId(strconv.FormatInt(result.ID, 10))
 
-	return resourceNetboxVpnTunnelRead(d, m)
+	return resourceNetboxVpnTunnelRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelsReadParams().WithID(id)
 
-	res, err := api.Vpn.VpnTunnelsRead(params, nil)
-	if err != nil {
-		if errresp, ok := err.(*vpn.VpnTunnelsReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+	var tunnel vpnTunnel
+	if err := api.getRaw(ctx, fmt.Sprintf("vpn/tunnels/%s", d.Id()), nil, &tunnel); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
-	tunnel := res.GetPayload()
 	d.Set("name", tunnel.Name)
-	d.Set("encapsulation", tunnel.Encapsulation.Value)
-	d.Set("status", tunnel.Status.Value)
+	if tunnel.Encapsulation != nil {
+		d.Set("encapsulation", tunnel.Encapsulation.Value)
+	}
+	if tunnel.Status != nil {
+		d.Set("status", tunnel.Status.Value)
+	}
 
 	if tunnel.Group != nil {
 		d.Set("tunnel_group_id", tunnel.Group.ID)
@@ -130,53 +174,40 @@ func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("description", tunnel.Description)
 
-	api.readTags(d, res.GetPayload().Tags)
+	if tunnel.IpsecProfile != nil {
+		d.Set("ipsec_profile_id", tunnel.IpsecProfile.ID)
+	} else {
+		d.Set("ipsec_profile_id", nil)
+	}
+
+	api.readTags(d, tunnel.Tags)
 	return nil
 }
 
-func resourceNetboxVpnTunnelUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	data := models.WritableTunnel{}
-
-	data.Name = strToPtr(d.Get("name").(string))
-	data.Encapsulation = strToPtr(d.Get("encapsulation").(string))
-	data.Status = strToPtr(d.Get("status").(string))
-	data.Group = int64ToPtr(int64(d.Get("tunnel_group_id").(int)))
-
-	data.Description = getOptionalStr(d, "description", false)
-	data.Tenant = getOptionalInt(d, "tenant_id")
-	data.TunnelID = getOptionalInt(d, "tunnel_id")
-
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
-	data.Tags = tags
-
-	params := vpn.NewVpnTunnelsUpdateParams().WithID(id).WithData(&data)
-
-	_, err := api.Vpn.VpnTunnelsUpdate(params, nil)
+	data, err := getWritableVpnTunnel(api, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceNetboxVpnTunnelRead(d, m)
+	if err := api.putRaw(ctx, fmt.Sprintf("vpn/tunnels/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxVpnTunnelRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelsDeleteParams().WithID(id)
-
-	_, err := api.Vpn.VpnTunnelsDelete(params, nil)
-	if err != nil {
-		if errresp, ok := err.(*vpn.VpnTunnelsDeleteDefault); ok {
-			if errresp.Code() == 404 {
-				d.SetId("")
-				return nil
-			}
+	if err := api.deleteRaw(ctx, fmt.Sprintf("vpn/tunnels/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -59,6 +59,67 @@ resource "netbox_vpn_tunnel" "test" {
 	})
 }
 
+func TestAccNetboxVpnTunnel_ipsecProfile(t *testing.T) {
+	testSlug := "vpntun_ipsec"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.test.id
+  ipsec_policy_id = netbox_ipsec_policy.test.id
+}
+resource "netbox_vpn_tunnel_group" "test" {
+  name = "%[1]s"
+}
+resource "netbox_vpn_tunnel" "test" {
+  name = "%[1]s"
+  encapsulation = "ipsec-tunnel"
+  status = "active"
+  tunnel_group_id = netbox_vpn_tunnel_group.test.id
+  ipsec_profile_id = netbox_ipsec_profile.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_tunnel.test", "encapsulation", "ipsec-tunnel"),
+					resource.TestCheckResourceAttrPair("netbox_vpn_tunnel.test", "ipsec_profile_id", "netbox_ipsec_profile.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.test.id
+  ipsec_policy_id = netbox_ipsec_policy.test.id
+}
+resource "netbox_vpn_tunnel_group" "test" {
+  name = "%[1]s"
+}
+resource "netbox_vpn_tunnel" "test" {
+  name = "%[1]s"
+  encapsulation = "gre"
+  status = "active"
+  tunnel_group_id = netbox_vpn_tunnel_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_tunnel.test", "encapsulation", "gre"),
+					resource.TestCheckNoResourceAttr("netbox_vpn_tunnel.test", "ipsec_profile_id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vpn_tunnel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_vpn_tunnel", &resource.Sweeper{
 		Name:         "netbox_vpn_tunnel",
@@ -43,6 +43,20 @@ func resourceNetboxVrf() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 21),
 			},
+			"import_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"export_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 
 			tagsKey: tagsSchema,
 		},
@@ -78,8 +92,8 @@ func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	data.ExportTargets = []int64{}
-	data.ImportTargets = []int64{}
+	data.ExportTargets = toInt64List(d.Get("export_target_ids"))
+	data.ImportTargets = toInt64List(d.Get("import_target_ids"))
 
 	params := ipam.NewIpamVrfsCreateParams().WithData(&data)
 
@@ -125,6 +139,8 @@ func resourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("import_target_ids", getIDsFromNestedRouteTargets(vrf.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTargets(vrf.ExportTargets))
 	return nil
 }
 
@@ -141,8 +157,8 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Name = &name
 	data.Tags = tags
-	data.ExportTargets = []int64{}
-	data.ImportTargets = []int64{}
+	data.ExportTargets = toInt64List(d.Get("export_target_ids"))
+	data.ImportTargets = toInt64List(d.Get("import_target_ids"))
 	data.Description = getOptionalStr(d, "description", true)
 	data.EnforceUnique = enforceUnique
 
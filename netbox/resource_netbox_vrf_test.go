@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -201,6 +202,58 @@ resource "netbox_vrf" "test_enforce_unique_false" {
 	})
 }
 
+func TestAccNetboxVrf_routeTargets(t *testing.T) {
+	testSlug := "vrf_rt"
+	testName := testAccGetTestName(testSlug)
+	// Route target names are limited to 21 characters
+	rtValue := acctest.RandIntRange(1, 1000000)
+	dependencies := fmt.Sprintf(`
+resource "netbox_route_target" "import" {
+  name = "65000:%[1]d"
+}
+
+resource "netbox_route_target" "export" {
+  name = "65001:%[1]d"
+}
+`, rtValue)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name              = "%s"
+  import_target_ids = [netbox_route_target.import.id, netbox_route_target.export.id]
+  export_target_ids = [netbox_route_target.export.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.test", "import_target_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_vrf.test", "import_target_ids.*", "netbox_route_target.import", "id"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_vrf.test", "import_target_ids.*", "netbox_route_target.export", "id"),
+					resource.TestCheckResourceAttr("netbox_vrf.test", "export_target_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_vrf.test", "export_target_ids.*", "netbox_route_target.export", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vrf.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.test", "import_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_vrf.test", "export_target_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_vrf", &resource.Sweeper{
 		Name:         "netbox_vrf",
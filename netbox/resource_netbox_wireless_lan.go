@@ -0,0 +1,322 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxWirelessLanStatusOptions = []string{"active", "reserved", "disabled", "deprecated"}
+var resourceNetboxWirelessAuthTypeOptions = []string{"open", "wep", "wpa-personal", "wpa-enterprise"}
+var resourceNetboxWirelessAuthCipherOptions = []string{"auto", "tkip", "aes"}
+
+// wirelessLan is a wireless LAN as returned by the API. The go-netbox models
+// lack the scope of wireless LANs.
+type wirelessLan struct {
+	ID           int64                          `json:"id"`
+	Ssid         string                         `json:"ssid"`
+	Description  string                         `json:"description"`
+	Group        *models.NestedWirelessLANGroup `json:"group"`
+	Status       *models.WirelessLANStatus      `json:"status"`
+	Vlan         *models.NestedVLAN             `json:"vlan"`
+	ScopeType    *string                        `json:"scope_type"`
+	ScopeID      *int64                         `json:"scope_id"`
+	Tenant       *models.NestedTenant           `json:"tenant"`
+	AuthType     *models.WirelessLANAuthType    `json:"auth_type"`
+	AuthCipher   *models.WirelessLANAuthCipher  `json:"auth_cipher"`
+	AuthPsk      string                         `json:"auth_psk"`
+	Comments     string                         `json:"comments"`
+	Tags         []*models.NestedTag            `json:"tags"`
+	CustomFields interface{}                    `json:"custom_fields"`
+}
+
+type writableWirelessLan struct {
+	Ssid         string              `json:"ssid"`
+	Description  string              `json:"description"`
+	Group        *int64              `json:"group"`
+	Status       string              `json:"status"`
+	Vlan         *int64              `json:"vlan"`
+	ScopeType    *string             `json:"scope_type"`
+	ScopeID      *int64              `json:"scope_id"`
+	Tenant       *int64              `json:"tenant"`
+	AuthType     string              `json:"auth_type"`
+	AuthCipher   string              `json:"auth_cipher"`
+	AuthPsk      string              `json:"auth_psk"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxWirelessLan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxWirelessLanCreate,
+		ReadContext:   resourceNetboxWirelessLanRead,
+		UpdateContext: resourceNetboxWirelessLanUpdate,
+		DeleteContext: resourceNetboxWirelessLanDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslan/):
+
+> A wireless LAN is a set of interfaces connected via a common wireless channel, identified by its SSID and authentication parameters. Wireless interfaces can be associated with wireless LANs to model multi-access wireless segments.`,
+
+		Schema: map[string]*schema.Schema{
+			"ssid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLanStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLanStatusOptions) + ". Defaults to `active`.",
+			},
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"site_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"site_group_id", "location_id", "region_id"},
+			},
+			"site_group_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"site_id", "location_id", "region_id"},
+			},
+			"location_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"site_id", "site_group_id", "region_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"site_id", "site_group_id", "location_id"},
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthTypeOptions),
+			},
+			"auth_cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthCipherOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthCipherOptions),
+			},
+			"auth_psk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The pre-shared key.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableWirelessLan(api *providerState, d *schema.ResourceData) (*writableWirelessLan, error) {
+	data := writableWirelessLan{
+		Ssid:        d.Get("ssid").(string),
+		Description: d.Get("description").(string),
+		Group:       getOptionalInt(d, "group_id"),
+		Status:      d.Get("status").(string),
+		Vlan:        getOptionalInt(d, "vlan_id"),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		AuthType:    d.Get("auth_type").(string),
+		AuthCipher:  d.Get("auth_cipher").(string),
+		AuthPsk:     d.Get("auth_psk").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	data.ScopeType, data.ScopeID = getWirelessLanScope(d)
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func getWirelessLanScope(d *schema.ResourceData) (*string, *int64) {
+	siteID := getOptionalInt(d, "site_id")
+	siteGroupID := getOptionalInt(d, "site_group_id")
+	locationID := getOptionalInt(d, "location_id")
+	regionID := getOptionalInt(d, "region_id")
+
+	switch {
+	case siteID != nil:
+		return strToPtr("dcim.site"), siteID
+	case siteGroupID != nil:
+		return strToPtr("dcim.sitegroup"), siteGroupID
+	case locationID != nil:
+		return strToPtr("dcim.location"), locationID
+	case regionID != nil:
+		return strToPtr("dcim.region"), regionID
+	}
+	return nil, nil
+}
+
+func resourceNetboxWirelessLanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableWirelessLan(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result wirelessLan
+	if err := api.postRaw(ctx, "wireless/wireless-lans", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxWirelessLanRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var lan wirelessLan
+	if err := api.getRaw(ctx, fmt.Sprintf("wireless/wireless-lans/%s", d.Id()), nil, &lan); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	setWirelessLanResourceData(d, &lan)
+
+	cf := api.getCustomFields(lan.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, lan.Tags)
+
+	return nil
+}
+
+// setWirelessLanResourceData sets the attributes shared by the wireless LAN
+// resource and data source.
+func setWirelessLanResourceData(d *schema.ResourceData, lan *wirelessLan) {
+	d.Set("ssid", lan.Ssid)
+	d.Set("description", lan.Description)
+	d.Set("auth_psk", lan.AuthPsk)
+	d.Set("comments", lan.Comments)
+
+	if lan.Group != nil {
+		d.Set("group_id", lan.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	if lan.Status != nil {
+		d.Set("status", lan.Status.Value)
+	}
+	if lan.Vlan != nil {
+		d.Set("vlan_id", lan.Vlan.ID)
+	} else {
+		d.Set("vlan_id", nil)
+	}
+	if lan.Tenant != nil {
+		d.Set("tenant_id", lan.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	if lan.AuthType != nil {
+		d.Set("auth_type", lan.AuthType.Value)
+	} else {
+		d.Set("auth_type", nil)
+	}
+	if lan.AuthCipher != nil {
+		d.Set("auth_cipher", lan.AuthCipher.Value)
+	} else {
+		d.Set("auth_cipher", nil)
+	}
+
+	d.Set("site_id", nil)
+	d.Set("site_group_id", nil)
+	d.Set("location_id", nil)
+	d.Set("region_id", nil)
+
+	if lan.ScopeType != nil && lan.ScopeID != nil {
+		switch *lan.ScopeType {
+		case "dcim.site":
+			d.Set("site_id", lan.ScopeID)
+		case "dcim.sitegroup":
+			d.Set("site_group_id", lan.ScopeID)
+		case "dcim.location":
+			d.Set("location_id", lan.ScopeID)
+		case "dcim.region":
+			d.Set("region_id", lan.ScopeID)
+		}
+	}
+}
+
+func resourceNetboxWirelessLanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableWirelessLan(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("wireless/wireless-lans/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxWirelessLanRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("wireless/wireless-lans/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
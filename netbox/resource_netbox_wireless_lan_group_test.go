@@ -0,0 +1,49 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLanGroup_basic(t *testing.T) {
+	testSlug := "wlan_grp_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_wireless_lan_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_wireless_lan_group" "test" {
+  name        = "%[1]s"
+  parent_id   = netbox_wireless_lan_group.parent.id
+  description = "test"
+  tags        = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan_group.test", "parent_id", "netbox_wireless_lan_group.parent", "id"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "tags.0", testName+"a"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_lan_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLan_basic(t *testing.T) {
+	testSlug := "wlan_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 1003
+}
+
+resource "netbox_wireless_lan_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_wireless_lan" "test" {
+  ssid        = "%[1]s"
+  group_id    = netbox_wireless_lan_group.test.id
+  status      = "reserved"
+  vlan_id     = netbox_vlan.test.id
+  site_id     = netbox_site.test.id
+  tenant_id   = netbox_tenant.test.id
+  auth_type   = "wpa-personal"
+  auth_cipher = "aes"
+  auth_psk    = "supersecret"
+  description = "test"
+  comments    = "comment"
+  tags        = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "ssid", testName),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "group_id", "netbox_wireless_lan_group.test", "id"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "status", "reserved"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "vlan_id", "netbox_vlan.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "site_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_type", "wpa-personal"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_cipher", "aes"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_psk", "supersecret"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "comments", "comment"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "tags.0", testName+"a"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_lan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,282 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxWirelessLinkStatusOptions = []string{"connected", "planned", "decommissioning"}
+var resourceNetboxWirelessLinkDistanceUnitOptions = []string{"km", "m", "mi", "ft"}
+
+// wirelessLink is a wireless link as returned by the API. The go-netbox
+// models lack the distance of wireless links.
+type wirelessLink struct {
+	ID           int64                          `json:"id"`
+	InterfaceA   *models.NestedInterface        `json:"interface_a"`
+	InterfaceB   *models.NestedInterface        `json:"interface_b"`
+	Ssid         string                         `json:"ssid"`
+	Status       *models.WirelessLinkStatus     `json:"status"`
+	Tenant       *models.NestedTenant           `json:"tenant"`
+	AuthType     *models.WirelessLinkAuthType   `json:"auth_type"`
+	AuthCipher   *models.WirelessLinkAuthCipher `json:"auth_cipher"`
+	AuthPsk      string                         `json:"auth_psk"`
+	Distance     *float64                       `json:"distance"`
+	DistanceUnit *struct {
+		Value string `json:"value"`
+	} `json:"distance_unit"`
+	Description  string              `json:"description"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+type writableWirelessLink struct {
+	InterfaceA   int64               `json:"interface_a"`
+	InterfaceB   int64               `json:"interface_b"`
+	Ssid         string              `json:"ssid"`
+	Status       string              `json:"status"`
+	Tenant       *int64              `json:"tenant"`
+	AuthType     string              `json:"auth_type"`
+	AuthCipher   string              `json:"auth_cipher"`
+	AuthPsk      string              `json:"auth_psk"`
+	Distance     *float64            `json:"distance"`
+	DistanceUnit *string             `json:"distance_unit"`
+	Description  string              `json:"description"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields,omitempty"`
+}
+
+func resourceNetboxWirelessLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxWirelessLinkCreate,
+		ReadContext:   resourceNetboxWirelessLinkRead,
+		UpdateContext: resourceNetboxWirelessLinkUpdate,
+		DeleteContext: resourceNetboxWirelessLinkDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslink/):
+
+> A wireless link represents a connection between exactly two wireless interfaces. It may optionally be assigned an SSID and a description. It may also have a status assigned to it, similar to the cable model. Each wireless link may also be assigned to a particular tenant.`,
+
+		Schema: map[string]*schema.Schema{
+			"interface_a_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"interface_b_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "connected",
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLinkStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLinkStatusOptions) + ". Defaults to `connected`.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthTypeOptions),
+			},
+			"auth_cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthCipherOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthCipherOptions),
+			},
+			"auth_psk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The pre-shared key.",
+			},
+			"distance": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"distance_unit"},
+			},
+			"distance_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"distance"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLinkDistanceUnitOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLinkDistanceUnitOptions),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getWritableWirelessLink(api *providerState, d *schema.ResourceData) (*writableWirelessLink, error) {
+	data := writableWirelessLink{
+		InterfaceA:  int64(d.Get("interface_a_id").(int)),
+		InterfaceB:  int64(d.Get("interface_b_id").(int)),
+		Ssid:        d.Get("ssid").(string),
+		Status:      d.Get("status").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		AuthType:    d.Get("auth_type").(string),
+		AuthCipher:  d.Get("auth_cipher").(string),
+		AuthPsk:     d.Get("auth_psk").(string),
+		Distance:    getOptionalFloat(d, "distance"),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	if distanceUnit, ok := d.GetOk("distance_unit"); ok {
+		data.DistanceUnit = strToPtr(distanceUnit.(string))
+	}
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields, err = api.getCustomFieldsForAPI(cf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxWirelessLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableWirelessLink(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result wirelessLink
+	if err := api.postRaw(ctx, "wireless/wireless-links", data, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceNetboxWirelessLinkRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var link wirelessLink
+	if err := api.getRaw(ctx, fmt.Sprintf("wireless/wireless-links/%s", d.Id()), nil, &link); err != nil {
+		if isRawNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	setWirelessLinkResourceData(d, &link)
+
+	cf := api.getCustomFields(link.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	api.readTags(d, link.Tags)
+
+	return nil
+}
+
+// setWirelessLinkResourceData sets the attributes shared by the wireless link
+// resource and data source.
+func setWirelessLinkResourceData(d *schema.ResourceData, link *wirelessLink) {
+	if link.InterfaceA != nil {
+		d.Set("interface_a_id", link.InterfaceA.ID)
+	}
+	if link.InterfaceB != nil {
+		d.Set("interface_b_id", link.InterfaceB.ID)
+	}
+	d.Set("ssid", link.Ssid)
+	if link.Status != nil {
+		d.Set("status", link.Status.Value)
+	}
+	if link.Tenant != nil {
+		d.Set("tenant_id", link.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	if link.AuthType != nil {
+		d.Set("auth_type", link.AuthType.Value)
+	} else {
+		d.Set("auth_type", nil)
+	}
+	if link.AuthCipher != nil {
+		d.Set("auth_cipher", link.AuthCipher.Value)
+	} else {
+		d.Set("auth_cipher", nil)
+	}
+	d.Set("auth_psk", link.AuthPsk)
+	d.Set("distance", link.Distance)
+	if link.DistanceUnit != nil {
+		d.Set("distance_unit", link.DistanceUnit.Value)
+	} else {
+		d.Set("distance_unit", nil)
+	}
+	d.Set("description", link.Description)
+	d.Set("comments", link.Comments)
+}
+
+func resourceNetboxWirelessLinkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := getWritableWirelessLink(api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := api.putRaw(ctx, fmt.Sprintf("wireless/wireless-links/%s", d.Id()), data, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxWirelessLinkRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := api.deleteRaw(ctx, fmt.Sprintf("wireless/wireless-links/%s", d.Id())); err != nil {
+		if isRawNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
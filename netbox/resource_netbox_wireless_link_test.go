@@ -0,0 +1,106 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxWirelessLinkFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "a" {
+  name           = "%[1]s-a"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device" "b" {
+  name           = "%[1]s-b"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "a" {
+  name      = "wlan0"
+  device_id = netbox_device.a.id
+  type      = "ieee802.11ac"
+}
+
+resource "netbox_device_interface" "b" {
+  name      = "wlan0"
+  device_id = netbox_device.b.id
+  type      = "ieee802.11ac"
+}
+`, testName)
+}
+
+func TestAccNetboxWirelessLink_basic(t *testing.T) {
+	testSlug := "wlink_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxWirelessLinkFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_wireless_link" "test" {
+  interface_a_id = netbox_device_interface.a.id
+  interface_b_id = netbox_device_interface.b.id
+  ssid           = "%[1]s"
+  status         = "planned"
+  auth_type      = "wpa-personal"
+  auth_cipher    = "aes"
+  auth_psk       = "supersecret"
+  distance       = 1.5
+  distance_unit  = "km"
+  description    = "test"
+  tags           = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_wireless_link.test", "interface_a_id", "netbox_device_interface.a", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_link.test", "interface_b_id", "netbox_device_interface.b", "id"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "ssid", testName),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "auth_type", "wpa-personal"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "auth_cipher", "aes"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "auth_psk", "supersecret"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "distance", "1.5"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "distance_unit", "km"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "tags.0", testName+"a"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}